@@ -5,15 +5,42 @@ import "context"
 func Default() *Client { return DefaultClient }
 
 func FetchApp(ctx context.Context, opts AppOptions) (App, error) { return DefaultClient.App(ctx, opts) }
+func FetchAppLocales(ctx context.Context, opts AppLocalesOptions) (AppLocalesResult, error) {
+	return DefaultClient.AppLocales(ctx, opts)
+}
+func FetchAvailability(ctx context.Context, opts AvailabilityOptions) (AvailabilityResult, error) {
+	return DefaultClient.Availability(ctx, opts)
+}
+func FetchTrackRanks(ctx context.Context, opts TrackRanksOptions) ([]KeywordRank, error) {
+	return DefaultClient.TrackRanks(ctx, opts)
+}
+func FetchSnapshotCharts(ctx context.Context, opts SnapshotChartsOptions) ([]ChartSnapshot, error) {
+	return DefaultClient.SnapshotCharts(ctx, opts)
+}
+func FetchSnapshot(ctx context.Context, opts SnapshotOptions) (SnapshotResult, error) {
+	return DefaultClient.Snapshot(ctx, opts)
+}
 func FetchList(ctx context.Context, opts ListOptions) ([]App, error) {
 	return DefaultClient.List(ctx, opts)
 }
+func FetchShelves(ctx context.Context, opts ShelvesOptions) ([]Shelf, error) {
+	return DefaultClient.Shelves(ctx, opts)
+}
 func FetchSearch(ctx context.Context, opts SearchOptions) ([]App, error) {
 	return DefaultClient.Search(ctx, opts)
 }
+func FetchSearchDetailed(ctx context.Context, opts SearchOptions) (SearchResult, error) {
+	return DefaultClient.SearchDetailed(ctx, opts)
+}
 func FetchDeveloper(ctx context.Context, opts DeveloperOptions) ([]App, error) {
 	return DefaultClient.Developer(ctx, opts)
 }
+func FetchExpandKeywords(ctx context.Context, opts ExpandKeywordsOptions) (KeywordExpansion, error) {
+	return DefaultClient.ExpandKeywords(ctx, opts)
+}
+func FetchSuggestDetailed(ctx context.Context, opts SuggestOptions) ([]Suggestion, error) {
+	return DefaultClient.SuggestDetailed(ctx, opts)
+}
 func FetchSuggest(ctx context.Context, opts SuggestOptions) ([]string, error) {
 	return DefaultClient.Suggest(ctx, opts)
 }
@@ -23,6 +50,12 @@ func FetchReviews(ctx context.Context, opts ReviewsOptions) (ReviewsResult, erro
 func FetchSimilar(ctx context.Context, opts SimilarOptions) ([]App, error) {
 	return DefaultClient.Similar(ctx, opts)
 }
+func FetchCluster(ctx context.Context, opts ClusterOptions) ([]App, error) {
+	return DefaultClient.Cluster(ctx, opts)
+}
+func FetchRelatedClusters(ctx context.Context, opts RelatedClustersOptions) ([]AppCluster, error) {
+	return DefaultClient.RelatedClusters(ctx, opts)
+}
 func FetchPermissions(ctx context.Context, opts PermissionsOptions) (PermissionsResult, error) {
 	return DefaultClient.Permissions(ctx, opts)
 }
@@ -32,3 +65,12 @@ func FetchDataSafety(ctx context.Context, opts DataSafetyOptions) (DataSafetyRes
 func FetchCategories(ctx context.Context, opts CategoriesOptions) ([]string, error) {
 	return DefaultClient.Categories(ctx, opts)
 }
+func FetchCategoryTaxonomy(ctx context.Context, opts CategoryTaxonomyOptions) (CategoryTaxonomy, error) {
+	return DefaultClient.CategoryTaxonomy(ctx, opts)
+}
+func FetchCrawl(ctx context.Context, opts CrawlOptions) (CrawlResult, error) {
+	return DefaultClient.Crawl(ctx, opts)
+}
+func FetchGraph(ctx context.Context, opts GraphOptions) (AppGraph, error) {
+	return DefaultClient.Graph(ctx, opts)
+}
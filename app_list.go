@@ -1,7 +1,6 @@
 package gplay
 
 import (
-	"strconv"
 	"strings"
 )
 
@@ -13,7 +12,9 @@ func extractDeveloperID(link string) string {
 	return parts[1]
 }
 
-type appListExtractor struct{}
+type appListExtractor struct {
+	lang string
+}
 
 func (a appListExtractor) itemMappings() map[string]fieldSpec {
 	return map[string]fieldSpec{
@@ -36,57 +37,32 @@ func (a appListExtractor) itemMappings() map[string]fieldSpec {
 			s, _ := asString(input)
 			return s
 		}},
-		"currency": {Path: []any{7, 0, 3, 2, 1, 0, 1}},
-		"price": {Path: []any{7, 0, 3, 2, 1, 0, 2}, Fn: func(input any, _ parsedData) any {
-			if input == nil {
-				return float64(0)
-			}
-			s, _ := asString(input)
-			if s == "" {
-				return float64(0)
-			}
-			start := -1
-			end := -1
-			for i := 0; i < len(s); i++ {
-				ch := s[i]
-				if (ch >= '0' && ch <= '9') || ch == '.' || ch == ',' {
-					if start == -1 {
-						start = i
-					}
-					end = i + 1
-				} else if start != -1 {
-					break
-				}
-			}
-			if start == -1 || end == -1 {
-				return float64(0)
-			}
-			num := strings.ReplaceAll(s[start:end], ",", "")
-			f, err := strconv.ParseFloat(num, 64)
-			if err != nil {
-				return float64(0)
-			}
-			return f
-		}},
+		"currency":    {Path: []any{7, 0, 3, 2, 1, 0, 1}},
+		"price":       {Path: []any{7, 0, 3, 2, 1, 0, 0}, Fn: priceFromMicros},
+		"priceMicros": {Path: []any{7, 0, 3, 2, 1, 0, 0}, Fn: priceMicros},
 		"free": {Path: []any{7, 0, 3, 2, 1, 0, 2}, Fn: func(input any, _ parsedData) any {
 			return input == nil
 		}},
 		"summary":   {Path: []any{4, 1, 1, 1, 1}},
 		"scoreText": {Path: []any{6, 0, 2, 1, 0}},
 		"score":     {Path: []any{6, 0, 2, 1, 1}},
+		"installs":  {Path: []any{6, 0, 3, 1, 0}, Fn: installsText},
+		"sponsored": sponsoredSpec,
 	}
 }
 
-func extractAppList(root []any, data any) []map[string]any {
+func extractAppList(root []any, data any, lang string) []map[string]any {
 	inputAny := pathGet(data, root)
 	input, ok := inputAny.([]any)
 	if !ok {
 		return nil
 	}
-	m := appListExtractor{}.itemMappings()
+	ex := appListExtractor{lang: lang}
+	m := ex.itemMappings()
 	out := make([]map[string]any, 0, len(input))
 	for _, it := range input {
 		item := extractFields(parsedData{"root": it}, prefixMappings(m))
+		fillFromText(item, ex.lang)
 		out = append(out, item)
 	}
 	return out
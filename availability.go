@@ -0,0 +1,124 @@
+package gplay
+
+import (
+	"context"
+	"math"
+	"strings"
+)
+
+type AvailabilityStatus string
+
+const (
+	AvailabilityAvailable      AvailabilityStatus = "available"
+	AvailabilityNotInCountry   AvailabilityStatus = "not_available_in_country"
+	AvailabilityRemoved        AvailabilityStatus = "removed"
+	AvailabilityPreregistering AvailabilityStatus = "preregistering"
+)
+
+var DefaultCountries = []string{
+	"us", "gb", "ca", "au", "nz", "ie", "in", "de", "fr", "es", "it", "pt", "nl", "be",
+	"at", "ch", "se", "no", "dk", "fi", "pl", "cz", "ro", "hu", "gr", "tr", "ru", "ua",
+	"br", "mx", "ar", "cl", "co", "pe", "jp", "kr", "tw", "hk", "sg", "my", "th", "id",
+	"ph", "vn", "sa", "ae", "eg", "za", "ng", "ke", "il", "pk", "bd",
+}
+
+type CountryAvailability struct {
+	Country         string             `json:"country"`
+	Status          AvailabilityStatus `json:"status"`
+	Free            *bool              `json:"free,omitempty"`
+	PriceText       *string            `json:"priceText,omitempty"`
+	Price           *Money             `json:"price,omitempty"`
+	OriginalPrice   *Money             `json:"originalPrice,omitempty"`
+	DiscountPercent *float64           `json:"discountPercent,omitempty"`
+	DiscountEndDate *string            `json:"discountEndDate,omitempty"`
+}
+
+type AvailabilityResult struct {
+	AppID     string                `json:"appId"`
+	Countries []CountryAvailability `json:"countries"`
+}
+
+func (c *Client) Availability(ctx context.Context, opts AvailabilityOptions) (AvailabilityResult, error) {
+	if opts.AppID == "" {
+		return AvailabilityResult{}, optionError("appId missing")
+	}
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	countries := opts.Countries
+	if len(countries) == 0 {
+		countries = DefaultCountries
+	}
+
+	rows := make([]CountryAvailability, len(countries))
+	err := fanOut(ctx, len(countries), opts.Concurrency, func(ctx context.Context, i int) error {
+		country := strings.ToLower(countries[i])
+		app, err := c.App(ctx, AppOptions{CallOptions: opts.CallOptions, AppID: opts.AppID, Lang: lang, Country: country})
+		if isNotFound(err) {
+			rows[i] = CountryAvailability{Country: country, Status: AvailabilityRemoved}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		rows[i] = countryAvailability(country, app)
+		return nil
+	})
+	if err != nil {
+		return AvailabilityResult{}, err
+	}
+
+	resolveNotFound(rows)
+	return AvailabilityResult{AppID: opts.AppID, Countries: rows}, nil
+}
+
+func resolveNotFound(rows []CountryAvailability) {
+	for _, r := range rows {
+		if r.Status != AvailabilityRemoved {
+			for i := range rows {
+				if rows[i].Status == AvailabilityRemoved {
+					rows[i].Status = AvailabilityNotInCountry
+				}
+			}
+			return
+		}
+	}
+}
+
+func countryAvailability(country string, app App) CountryAvailability {
+	row := CountryAvailability{
+		Country:         country,
+		Status:          AvailabilityNotInCountry,
+		Free:            app.Free,
+		PriceText:       app.PriceText,
+		DiscountEndDate: app.DiscountEndDate,
+	}
+	switch {
+	case app.Preregister != nil && *app.Preregister:
+		row.Status = AvailabilityPreregistering
+	case app.Available != nil && *app.Available:
+		row.Status = AvailabilityAvailable
+	}
+	if m, ok := app.PriceMoney(); ok {
+		row.Price = &m
+	}
+	if m, ok := app.OriginalPriceMoney(); ok {
+		row.OriginalPrice = &m
+		if row.Price != nil && m.Micros > 0 {
+			pct := math.Round(float64(m.Micros-row.Price.Micros)/float64(m.Micros)*1000) / 10
+			row.DiscountPercent = &pct
+		}
+	}
+	return row
+}
+
+func (r AvailabilityResult) AvailableIn() []string {
+	out := make([]string, 0, len(r.Countries))
+	for _, row := range r.Countries {
+		if row.Status == AvailabilityAvailable {
+			out = append(out, row.Country)
+		}
+	}
+	return out
+}
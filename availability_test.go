@@ -0,0 +1,56 @@
+package gplay
+
+import "testing"
+
+func TestResolveNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []AvailabilityStatus
+		want []AvailabilityStatus
+	}{
+		{"removed everywhere", []AvailabilityStatus{AvailabilityRemoved, AvailabilityRemoved}, []AvailabilityStatus{AvailabilityRemoved, AvailabilityRemoved}},
+		{"regional 404", []AvailabilityStatus{AvailabilityAvailable, AvailabilityRemoved}, []AvailabilityStatus{AvailabilityAvailable, AvailabilityNotInCountry}},
+		{"preregistering elsewhere", []AvailabilityStatus{AvailabilityRemoved, AvailabilityPreregistering}, []AvailabilityStatus{AvailabilityNotInCountry, AvailabilityPreregistering}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := make([]CountryAvailability, len(tt.in))
+			for i, st := range tt.in {
+				rows[i] = CountryAvailability{Status: st}
+			}
+			resolveNotFound(rows)
+			for i, want := range tt.want {
+				if rows[i].Status != want {
+					t.Fatalf("row %d: got %q, want %q", i, rows[i].Status, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCountryAvailability(t *testing.T) {
+	yes, no := true, false
+	price, original := int64(990000), int64(1990000)
+	cur := "USD"
+	tests := []struct {
+		name string
+		app  App
+		want AvailabilityStatus
+	}{
+		{"available", App{Available: &yes}, AvailabilityAvailable},
+		{"not available", App{Available: &no}, AvailabilityNotInCountry},
+		{"preregistration", App{Available: &no, Preregister: &yes}, AvailabilityPreregistering},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countryAvailability("us", tt.app).Status; got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	row := countryAvailability("us", App{Available: &yes, Currency: &cur, PriceMicros: &price, OriginalPriceMicros: &original})
+	if row.Price == nil || row.Price.Currency != "USD" || row.DiscountPercent == nil || *row.DiscountPercent != 50.3 {
+		t.Fatalf("unexpected pricing %+v", row)
+	}
+}
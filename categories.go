@@ -24,14 +24,41 @@ func (c *Client) Categories(ctx context.Context, opts CategoriesOptions) ([]stri
 	if err != nil {
 		return nil, err
 	}
+	links, err := harvestCategoryLinks(body)
+	if err != nil {
+		return nil, err
+	}
+	categoryIDs := make([]string, 0, len(links))
+	seen := map[string]struct{}{}
+	for _, l := range links {
+		seen[l.ID] = struct{}{}
+		categoryIDs = append(categoryIDs, l.ID)
+	}
+
+	if _, ok := seen["APPLICATION"]; !ok {
+		categoryIDs = append(categoryIDs, "APPLICATION")
+	}
+	if len(categoryIDs) == 0 {
+		return nil, errors.New("no categories found")
+	}
+	c.cacheSet("categories", opts, categoryIDs)
+	return categoryIDs, nil
+}
+
+type categoryLink struct {
+	ID   string
+	Name string
+}
+
+func harvestCategoryLinks(body []byte) ([]categoryLink, error) {
 	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(body)))
 	if err != nil {
 		return nil, err
 	}
 
 	const prefix = "/store/apps/category/"
-	categoryIDs := make([]string, 0)
-	seen := map[string]struct{}{}
+	links := make([]categoryLink, 0)
+	seen := map[string]int{}
 	doc.Find("a").Each(func(_ int, s *goquery.Selection) {
 		href, ok := s.Attr("href")
 		if !ok {
@@ -50,16 +77,19 @@ func (c *Client) Categories(ctx context.Context, opts CategoriesOptions) ([]stri
 		if id == "" {
 			return
 		}
-		if _, ok := seen[id]; ok {
+		name := strings.TrimSpace(s.Text())
+		if i, ok := seen[id]; ok {
+			if links[i].Name == "" {
+				links[i].Name = name
+			}
 			return
 		}
-		seen[id] = struct{}{}
-		categoryIDs = append(categoryIDs, id)
+		seen[id] = len(links)
+		links = append(links, categoryLink{ID: id, Name: name})
 	})
 
-	if len(categoryIDs) < 5 {
-		re := regexp.MustCompile(`/store/apps/category/([A-Z0-9_]+)`)
-		for _, m := range re.FindAllStringSubmatch(string(body), -1) {
+	if len(links) < 5 {
+		for _, m := range categoryIDRe.FindAllStringSubmatch(string(body), -1) {
 			if len(m) != 2 {
 				continue
 			}
@@ -70,17 +100,11 @@ func (c *Client) Categories(ctx context.Context, opts CategoriesOptions) ([]stri
 			if _, ok := seen[id]; ok {
 				continue
 			}
-			seen[id] = struct{}{}
-			categoryIDs = append(categoryIDs, id)
+			seen[id] = len(links)
+			links = append(links, categoryLink{ID: id})
 		}
 	}
-
-	if _, ok := seen["APPLICATION"]; !ok {
-		categoryIDs = append(categoryIDs, "APPLICATION")
-	}
-	if len(categoryIDs) == 0 {
-		return nil, errors.New("no categories found")
-	}
-	c.cacheSet("categories", opts, categoryIDs)
-	return categoryIDs, nil
+	return links, nil
 }
+
+var categoryIDRe = regexp.MustCompile(`/store/apps/category/([A-Z0-9_]+)`)
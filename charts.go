@@ -0,0 +1,255 @@
+package gplay
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+type ChartEntry struct {
+	AppID string `json:"appId"`
+	Title string `json:"title"`
+	Rank  int    `json:"rank"`
+}
+
+type ChartSnapshot struct {
+	Collection Collection   `json:"collection"`
+	Category   Category     `json:"category"`
+	Country    string       `json:"country"`
+	Lang       string       `json:"lang"`
+	Device     Device       `json:"device,omitempty"`
+	TakenAt    time.Time    `json:"takenAt"`
+	Entries    []ChartEntry `json:"entries"`
+}
+
+func (s ChartSnapshot) key() string {
+	return string(s.Collection) + "\x00" + string(s.Category) + "\x00" + s.Country + "\x00" + string(normalizeDevice(s.Device))
+}
+
+type ChartQuery struct {
+	Collection Collection
+	Category   Category
+	Country    string
+	Device     Device
+	Since      time.Time
+	Until      time.Time
+}
+
+func (q ChartQuery) matches(s ChartSnapshot) bool {
+	if q.Collection != "" && q.Collection != s.Collection {
+		return false
+	}
+	if q.Category != "" && q.Category != s.Category {
+		return false
+	}
+	if q.Country != "" && q.Country != s.Country {
+		return false
+	}
+	if q.Device != "" && normalizeDevice(q.Device) != normalizeDevice(s.Device) {
+		return false
+	}
+	if !q.Since.IsZero() && s.TakenAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && s.TakenAt.After(q.Until) {
+		return false
+	}
+	return true
+}
+
+func normalizeDevice(d Device) Device {
+	if d == "" {
+		return DevicePhone
+	}
+	return d
+}
+
+type ChartStore interface {
+	SaveCharts(ctx context.Context, snapshots []ChartSnapshot) error
+	Charts(ctx context.Context, q ChartQuery) ([]ChartSnapshot, error)
+}
+
+type MemoryChartStore struct {
+	mu        sync.Mutex
+	snapshots []ChartSnapshot
+}
+
+func (s *MemoryChartStore) SaveCharts(_ context.Context, snapshots []ChartSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots = append(s.snapshots, snapshots...)
+	return nil
+}
+
+func (s *MemoryChartStore) Charts(_ context.Context, q ChartQuery) ([]ChartSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return filterRecords(s.snapshots, q.matches), nil
+}
+
+type FileChartStore struct {
+	Path string
+	mu   sync.Mutex
+}
+
+func (s *FileChartStore) SaveCharts(_ context.Context, snapshots []ChartSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return appendNDJSON(s.Path, snapshots)
+}
+
+func (s *FileChartStore) Charts(_ context.Context, q ChartQuery) ([]ChartSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return scanNDJSON(s.Path, q.matches)
+}
+
+func (c *Client) SnapshotCharts(ctx context.Context, opts SnapshotChartsOptions) ([]ChartSnapshot, error) {
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	collections := opts.Collections
+	if len(collections) == 0 {
+		collections = []Collection{CollectionTopFree, CollectionTopPaid, CollectionGrossing}
+	}
+	categories := opts.Categories
+	if len(categories) == 0 {
+		categories = []Category{CategoryApplication}
+	}
+	countries := opts.Countries
+	if len(countries) == 0 {
+		countries = []string{"us"}
+	}
+
+	type job struct {
+		collection Collection
+		category   Category
+		country    string
+	}
+	jobs := make([]job, 0, len(collections)*len(categories)*len(countries))
+	for _, col := range collections {
+		for _, cat := range categories {
+			if validateListOptions(col, cat, opts.Device) != nil {
+				continue
+			}
+			for _, cc := range countries {
+				jobs = append(jobs, job{col, cat, cc})
+			}
+		}
+	}
+	if len(jobs) == 0 {
+		return nil, optionError("no valid chart combinations")
+	}
+
+	snapshots := make([]ChartSnapshot, len(jobs))
+	err := fanOut(ctx, len(jobs), opts.Concurrency, func(ctx context.Context, i int) error {
+		j := jobs[i]
+		apps, err := c.List(ctx, ListOptions{CallOptions: opts.CallOptions, Collection: j.collection, Category: j.category, Device: opts.Device, Num: opts.Num, Lang: lang, Country: j.country})
+		if err != nil {
+			return err
+		}
+		snapshots[i] = newChartSnapshot(apps, j.collection, j.category, j.country, lang, opts.Device, time.Now().UTC())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if opts.Store != nil {
+		if err := opts.Store.SaveCharts(ctx, snapshots); err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+func newChartSnapshot(apps []App, collection Collection, category Category, country, lang string, device Device, at time.Time) ChartSnapshot {
+	snap := ChartSnapshot{Collection: collection, Category: category, Country: country, Lang: lang, Device: device, TakenAt: at}
+	snap.Entries = make([]ChartEntry, 0, len(apps))
+	seen := map[string]bool{}
+	for _, a := range apps {
+		if seen[a.AppID] {
+			continue
+		}
+		seen[a.AppID] = true
+		snap.Entries = append(snap.Entries, ChartEntry{AppID: a.AppID, Title: a.Title, Rank: len(snap.Entries) + 1})
+	}
+	return snap
+}
+
+type ChartMove struct {
+	AppID    string `json:"appId"`
+	Title    string `json:"title"`
+	Previous int    `json:"previous"`
+	Current  int    `json:"current"`
+	Delta    int    `json:"delta"`
+}
+
+type ChartMovement struct {
+	Collection Collection  `json:"collection"`
+	Category   Category    `json:"category"`
+	Country    string      `json:"country"`
+	From       time.Time   `json:"from"`
+	To         time.Time   `json:"to"`
+	New        []ChartMove `json:"new"`
+	Exits      []ChartMove `json:"exits"`
+	Climbers   []ChartMove `json:"climbers"`
+	Fallers    []ChartMove `json:"fallers"`
+}
+
+func CompareCharts(previous, current ChartSnapshot, limit int) (ChartMovement, error) {
+	if previous.key() != current.key() {
+		return ChartMovement{}, errors.New("snapshots belong to different charts")
+	}
+	mv := ChartMovement{
+		Collection: current.Collection,
+		Category:   current.Category,
+		Country:    current.Country,
+		From:       previous.TakenAt,
+		To:         current.TakenAt,
+		New:        make([]ChartMove, 0),
+		Exits:      make([]ChartMove, 0),
+		Climbers:   make([]ChartMove, 0),
+		Fallers:    make([]ChartMove, 0),
+	}
+
+	prev := make(map[string]ChartEntry, len(previous.Entries))
+	for _, e := range previous.Entries {
+		prev[e.AppID] = e
+	}
+	cur := make(map[string]bool, len(current.Entries))
+	for _, e := range current.Entries {
+		cur[e.AppID] = true
+		p, ok := prev[e.AppID]
+		if !ok {
+			mv.New = append(mv.New, ChartMove{AppID: e.AppID, Title: e.Title, Current: e.Rank})
+			continue
+		}
+		m := ChartMove{AppID: e.AppID, Title: e.Title, Previous: p.Rank, Current: e.Rank, Delta: p.Rank - e.Rank}
+		switch {
+		case m.Delta > 0:
+			mv.Climbers = append(mv.Climbers, m)
+		case m.Delta < 0:
+			mv.Fallers = append(mv.Fallers, m)
+		}
+	}
+	for _, e := range previous.Entries {
+		if !cur[e.AppID] {
+			mv.Exits = append(mv.Exits, ChartMove{AppID: e.AppID, Title: e.Title, Previous: e.Rank})
+		}
+	}
+
+	sort.SliceStable(mv.Climbers, func(i, j int) bool { return mv.Climbers[i].Delta > mv.Climbers[j].Delta })
+	sort.SliceStable(mv.Fallers, func(i, j int) bool { return mv.Fallers[i].Delta < mv.Fallers[j].Delta })
+	if limit > 0 {
+		if len(mv.Climbers) > limit {
+			mv.Climbers = mv.Climbers[:limit]
+		}
+		if len(mv.Fallers) > limit {
+			mv.Fallers = mv.Fallers[:limit]
+		}
+	}
+	return mv, nil
+}
@@ -0,0 +1,71 @@
+package gplay
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCompareCharts(t *testing.T) {
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	prev := newChartSnapshot([]App{{AppID: "a"}, {AppID: "b"}, {AppID: "c"}, {AppID: "d"}}, CollectionTopFree, CategoryApplication, "us", "en", "", day1)
+	cur := newChartSnapshot([]App{{AppID: "d"}, {AppID: "a"}, {AppID: "e"}, {AppID: "b"}}, CollectionTopFree, CategoryApplication, "us", "en", "", day1.Add(24*time.Hour))
+
+	mv, err := CompareCharts(prev, cur, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mv.New) != 1 || mv.New[0].AppID != "e" || mv.New[0].Current != 3 {
+		t.Fatalf("unexpected new entries %+v", mv.New)
+	}
+	if len(mv.Exits) != 1 || mv.Exits[0].AppID != "c" {
+		t.Fatalf("unexpected exits %+v", mv.Exits)
+	}
+	if len(mv.Climbers) != 1 || mv.Climbers[0].AppID != "d" || mv.Climbers[0].Delta != 3 {
+		t.Fatalf("unexpected climbers %+v", mv.Climbers)
+	}
+	if len(mv.Fallers) != 2 || mv.Fallers[0].AppID != "b" || mv.Fallers[0].Delta != -2 {
+		t.Fatalf("unexpected fallers %+v", mv.Fallers)
+	}
+
+	other := cur
+	other.Country = "gb"
+	if _, err := CompareCharts(prev, other, 0); err == nil {
+		t.Fatalf("expected mismatched chart error")
+	}
+
+	phone := cur
+	phone.Device = DevicePhone
+	if _, err := CompareCharts(prev, phone, 0); err != nil {
+		t.Fatalf("default and phone snapshots should compare: %v", err)
+	}
+	phone.Device = DeviceTablet
+	if _, err := CompareCharts(prev, phone, 0); err == nil {
+		t.Fatalf("expected mismatched device error")
+	}
+}
+
+func TestChartQueryDevice(t *testing.T) {
+	now := time.Now().UTC()
+	phone := newChartSnapshot([]App{{AppID: "a"}}, CollectionTopFree, CategoryGame, "us", "en", "", now)
+	tablet := newChartSnapshot([]App{{AppID: "b"}}, CollectionTopFree, CategoryGame, "us", "en", DeviceTablet, now)
+	store := &MemoryChartStore{}
+	if err := store.SaveCharts(context.Background(), []ChartSnapshot{phone, tablet}); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		device Device
+		want   int
+	}{
+		{"", 2},
+		{DevicePhone, 1},
+		{DeviceTablet, 1},
+		{DeviceTV, 0},
+	}
+	for _, tc := range cases {
+		got, _ := store.Charts(context.Background(), ChartQuery{Device: tc.device})
+		if len(got) != tc.want {
+			t.Errorf("%q: got %d snapshots, want %d", tc.device, len(got), tc.want)
+		}
+	}
+}
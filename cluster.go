@@ -0,0 +1,164 @@
+package gplay
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) Cluster(ctx context.Context, opts ClusterOptions) ([]App, error) {
+	if opts.URL == "" {
+		return nil, optionError("cluster URL missing")
+	}
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	country := opts.Country
+	if country == "" {
+		country = "us"
+	}
+	num := opts.Num
+	if num == 0 {
+		num = 60
+	}
+	cacheOpts := opts
+	cacheOpts.Lang = lang
+	cacheOpts.Country = country
+	cacheOpts.Num = num
+	if c != nil && c.cache != nil {
+		var cached []App
+		hit, err := c.cacheGet("cluster", cacheOpts, &cached)
+		if err != nil {
+			return nil, err
+		}
+		if hit {
+			return cached, nil
+		}
+	}
+
+	clusterURL, err := clusterPageURL(opts.URL, lang, country)
+	if err != nil {
+		return nil, err
+	}
+	appMaps, err := c.fetchCluster(ctx, opts.CallOptions, clusterURL, lang, country, num)
+	if err != nil {
+		return nil, err
+	}
+	apps, err := appsFromMaps(appMaps)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.FullDetail {
+		return c.fullDetailApps(ctx, opts.CallOptions, apps, lang, country)
+	}
+	c.cacheSet("cluster", cacheOpts, apps)
+	return apps, nil
+}
+
+func clusterPageURL(raw string, lang, country string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	if u.Host != "" && !strings.HasSuffix(u.Host, "play.google.com") {
+		return "", optionError("not a Google Play URL: " + raw)
+	}
+	if !strings.HasPrefix(u.Path, "/store/apps/") {
+		return "", optionError("not a Google Play apps cluster URL: " + raw)
+	}
+	qs := u.Query()
+	qs.Set("hl", lang)
+	qs.Set("gl", country)
+	return u.Path + "?" + encodeValues(qs), nil
+}
+
+func (c *Client) fetchCluster(ctx context.Context, callOpts CallOptions, clusterURL, lang, country string, num int) ([]map[string]any, error) {
+	body, _, err := c.do(ctx, requestOptions{URL: clusterURL, Headers: callOpts.Headers}, callOpts.Throttle)
+	if err != nil {
+		return nil, err
+	}
+	parsed := parseScriptData(body)
+
+	appsRoot := []any{"ds:3", 0, 1, 0, 21, 0}
+	tokenPath := []any{"ds:3", 0, 1, 0, 21, 1, 3, 1}
+	m := clusterItemMappings()
+	if _, ok := pathGet(parsed, appsRoot).([]any); !ok {
+		appsRoot = []any{"ds:3", 0, 1, 0, 22, 0}
+		tokenPath = []any{"ds:3", 0, 1, 0, 22, 1, 3, 1}
+		m = clusterItemMappings(0)
+	}
+
+	appsArr, _ := pathGet(parsed, appsRoot).([]any)
+	appMaps := make([]map[string]any, 0, len(appsArr))
+	for _, it := range appsArr {
+		fields := extractFields(parsedData{"root": it}, prefixMappings(m))
+		fillFromText(fields, lang)
+		appMaps = append(appMaps, fields)
+	}
+	token, _ := asString(pathGet(parsed, tokenPath))
+
+	page := pageMappings{Apps: []any{0, 0, 0}, Token: []any{0, 0, 7, 1}}
+	more, err := checkFinished(ctx, c, callOpts, lang, country, num, appMaps, token, page)
+	if err != nil {
+		return nil, err
+	}
+	assignPositions(more)
+	return more, nil
+}
+
+func clusterItemMappings(prefix ...any) map[string]fieldSpec {
+	p := func(path ...any) []any {
+		out := make([]any, 0, len(prefix)+len(path))
+		out = append(out, prefix...)
+		return append(out, path...)
+	}
+	return map[string]fieldSpec{
+		"title":       {Path: p(3)},
+		"appId":       {Path: p(0, 0)},
+		"url":         {Path: p(10, 4, 2), Fn: func(input any, _ parsedData) any { s, _ := asString(input); return resolveURL(BaseURL, s) }},
+		"icon":        {Path: p(1, 3, 2)},
+		"developer":   {Path: p(14)},
+		"currency":    {Path: p(8, 1, 0, 1)},
+		"price":       {Path: p(8, 1, 0, 0), Fn: priceFromMicros},
+		"priceMicros": {Path: p(8, 1, 0, 0), Fn: priceMicros},
+		"free":        {Path: p(8, 1, 0, 0), Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v == 0 }},
+		"summary":     {Path: p(13, 1)},
+		"scoreText":   {Path: p(4, 0)},
+		"score":       {Path: p(4, 1)},
+		"installs":    {Path: p(15), Fn: installsText},
+		"sponsored":   sponsoredSpec,
+	}
+}
+
+func appsFromMaps(maps []map[string]any) ([]App, error) {
+	apps := make([]App, 0, len(maps))
+	for _, mm := range maps {
+		b, err := json.Marshal(mm)
+		if err != nil {
+			return nil, err
+		}
+		var a App
+		if err := json.Unmarshal(b, &a); err != nil {
+			return nil, err
+		}
+		apps = append(apps, a)
+	}
+	return apps, nil
+}
+
+func (c *Client) fullDetailApps(ctx context.Context, callOpts CallOptions, apps []App, lang, country string) ([]App, error) {
+	out := make([]App, 0, len(apps))
+	for _, a := range apps {
+		full, err := c.App(ctx, AppOptions{CallOptions: callOpts, AppID: a.AppID, Lang: lang, Country: country})
+		if err != nil {
+			return nil, err
+		}
+		full.Sponsored = a.Sponsored
+		full.Position = a.Position
+		out = append(out, full)
+	}
+	return out, nil
+}
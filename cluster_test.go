@@ -0,0 +1,55 @@
+package gplay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClusterPageURL(t *testing.T) {
+	got, err := clusterPageURL("https://play.google.com/store/apps/collection/cluster?gsr=abc&hl=en", "de", "at")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "/store/apps/collection/cluster?gl=at&gsr=abc&hl=de" {
+		t.Fatalf("unexpected url %q", got)
+	}
+	if _, err := clusterPageURL("https://example.com/store/apps/collection/cluster?gsr=abc", "en", "us"); err == nil {
+		t.Fatalf("expected error for foreign host")
+	}
+	if _, err := clusterPageURL("/store/books/details?id=1", "en", "us"); err == nil {
+		t.Fatalf("expected error for non-apps path")
+	}
+}
+
+func TestFullDetailAppsKeepsListFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		details := make([]any, 141)
+		details[0] = []any{"Full " + r.URL.Query().Get("id")}
+		data, _ := json.Marshal([]any{nil, []any{nil, nil, details}})
+		fmt.Fprintf(w, "<script>AF_initDataCallback({key: 'ds:5', hash: '1', data:%s, sideChannel: {}});</script>", data)
+	}))
+	defer srv.Close()
+	c, err := NewClient(ClientOptions{BaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apps := []App{
+		{AppID: "com.ad", Sponsored: true, Position: &ResultPosition{Absolute: 1}},
+		{AppID: "com.a", Position: &ResultPosition{Absolute: 2, Organic: 1}},
+	}
+	full, err := c.fullDetailApps(context.Background(), CallOptions{Throttle: 100}, apps, "en", "us")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if full[0].Title != "Full com.ad" || !full[0].Sponsored || full[0].Position.Absolute != 1 {
+		t.Fatalf("unexpected sponsored app %+v", full[0])
+	}
+	if full[1].Title != "Full com.a" || full[1].Sponsored || full[1].Position.Organic != 1 {
+		t.Fatalf("unexpected organic app %+v", full[1])
+	}
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+)
+
+//go:embed openapi.json
+var openapiDoc []byte
+
+type server struct {
+	client   *gplay.Client
+	throttle int
+}
+
+type badRequest struct{ msg string }
+
+func (e badRequest) Error() string { return e.msg }
+
+type errorBody struct {
+	Error errorDetail `json:"error"`
+}
+
+type errorDetail struct {
+	Status         int    `json:"status"`
+	Message        string `json:"message"`
+	UpstreamStatus int    `json:"upstreamStatus,omitempty"`
+}
+
+type listBody struct {
+	Data                any     `json:"data"`
+	NextPaginationToken *string `json:"nextPaginationToken,omitempty"`
+}
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	throttle := flag.Int("throttle", 1, "maximum upstream requests per second")
+	maxAge := flag.Duration("cache-max-age", 5*time.Minute, "cache entry lifetime")
+	maxEntries := flag.Int("cache-max", 1000, "maximum cached entries")
+	flag.Parse()
+
+	s := &server{client: gplay.MemoizedClient(gplay.MemoizeOptions{MaxAge: *maxAge, Max: *maxEntries}), throttle: *throttle}
+	srv := &http.Server{Addr: *addr, Handler: s.routes(), ReadHeaderTimeout: 10 * time.Second}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		srv.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("listening on %s", *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openapiDoc)
+	})
+	mux.HandleFunc("GET /apps/{id}", s.handle(s.app))
+	mux.HandleFunc("GET /apps/{id}/reviews", s.handle(s.reviews))
+	mux.HandleFunc("GET /apps/{id}/similar", s.handle(s.similar))
+	mux.HandleFunc("GET /apps/{id}/permissions", s.handle(s.permissions))
+	mux.HandleFunc("GET /apps/{id}/datasafety", s.handle(s.dataSafety))
+	mux.HandleFunc("GET /developers/{id}", s.handle(s.developer))
+	mux.HandleFunc("GET /search", s.handle(s.search))
+	mux.HandleFunc("GET /list", s.handle(s.list))
+	mux.HandleFunc("GET /suggest", s.handle(s.suggest))
+	mux.HandleFunc("GET /categories", s.handle(s.categories))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not found", 0)
+	})
+	return mux
+}
+
+func (s *server) handle(fn func(r *http.Request, q query) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := query{values: r.URL.Query()}
+		v, err := fn(r, q)
+		if err != nil {
+			status, upstream := errorStatus(err)
+			writeError(w, status, err.Error(), upstream)
+			return
+		}
+		writeJSON(w, http.StatusOK, v)
+	}
+}
+
+func errorStatus(err error) (status, upstream int) {
+	var br badRequest
+	var re *gplay.RequestError
+	switch {
+	case errors.As(err, &br):
+		return http.StatusBadRequest, 0
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, 0
+	case errors.As(err, &re):
+		switch {
+		case re.StatusCode == http.StatusNotFound:
+			return http.StatusNotFound, re.StatusCode
+		case re.StatusCode == http.StatusTooManyRequests:
+			return http.StatusTooManyRequests, re.StatusCode
+		default:
+			return http.StatusBadGateway, re.StatusCode
+		}
+	case errors.Is(err, gplay.ErrSimilarNotFound):
+		return http.StatusNotFound, 0
+	case errors.Is(err, gplay.ErrInvalidOption):
+		return http.StatusBadRequest, 0
+	default:
+		return http.StatusInternalServerError, 0
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string, upstream int) {
+	writeJSON(w, status, errorBody{Error: errorDetail{Status: status, Message: msg, UpstreamStatus: upstream}})
+}
+
+type query struct {
+	values map[string][]string
+	err    error
+}
+
+func (q *query) str(key, def string) string {
+	if v := q.get(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func (q *query) get(key string) string {
+	if vv := q.values[key]; len(vv) > 0 {
+		return vv[0]
+	}
+	return ""
+}
+
+func (q *query) int(key string, def int) int {
+	v := q.get(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil && q.err == nil {
+		q.err = badRequest{fmt.Sprintf("invalid %s %q", key, v)}
+	}
+	return n
+}
+
+func (q *query) bool(key string) bool {
+	v := q.get(key)
+	if v == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil && q.err == nil {
+		q.err = badRequest{fmt.Sprintf("invalid %s %q", key, v)}
+	}
+	return b
+}
+
+func (q *query) required(key string) string {
+	v := q.get(key)
+	if v == "" && q.err == nil {
+		q.err = badRequest{key + " missing"}
+	}
+	return v
+}
+
+func (s *server) callOptions() gplay.CallOptions {
+	return gplay.CallOptions{Throttle: s.throttle}
+}
+
+func (s *server) app(r *http.Request, q query) (any, error) {
+	return s.client.App(r.Context(), gplay.AppOptions{CallOptions: s.callOptions(), AppID: r.PathValue("id"), Lang: q.get("lang"), Country: q.get("country")})
+}
+
+var reviewSorts = map[string]gplay.Sort{"newest": gplay.SortNewest, "rating": gplay.SortRating, "helpfulness": gplay.SortHelpfulness}
+
+func (s *server) reviews(r *http.Request, q query) (any, error) {
+	sortBy, ok := reviewSorts[q.str("sort", "newest")]
+	if !ok {
+		return nil, badRequest{"invalid sort " + q.get("sort")}
+	}
+	opts := gplay.ReviewsOptions{
+		CallOptions: s.callOptions(),
+		AppID:       r.PathValue("id"),
+		Lang:        q.get("lang"),
+		Country:     q.get("country"),
+		Sort:        sortBy,
+		Num:         q.int("num", 150),
+		Paginate:    true,
+	}
+	if token := q.get("token"); token != "" {
+		opts.NextPaginationToken = &token
+	}
+	if q.err != nil {
+		return nil, q.err
+	}
+	res, err := s.client.Reviews(r.Context(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return listBody{Data: res.Data, NextPaginationToken: res.NextPaginationToken}, nil
+}
+
+func (s *server) similar(r *http.Request, q query) (any, error) {
+	opts := gplay.SimilarOptions{CallOptions: s.callOptions(), AppID: r.PathValue("id"), Lang: q.get("lang"), Country: q.get("country"), Num: q.int("num", 0), FullDetail: q.bool("fullDetail")}
+	if q.err != nil {
+		return nil, q.err
+	}
+	apps, err := s.client.Similar(r.Context(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return listBody{Data: apps}, nil
+}
+
+func (s *server) permissions(r *http.Request, q query) (any, error) {
+	opts := gplay.PermissionsOptions{CallOptions: s.callOptions(), AppID: r.PathValue("id"), Lang: q.get("lang"), Country: q.get("country"), Short: q.bool("short")}
+	if q.err != nil {
+		return nil, q.err
+	}
+	return s.client.Permissions(r.Context(), opts)
+}
+
+func (s *server) dataSafety(r *http.Request, q query) (any, error) {
+	return s.client.DataSafety(r.Context(), gplay.DataSafetyOptions{CallOptions: s.callOptions(), AppID: r.PathValue("id"), Lang: q.get("lang")})
+}
+
+func (s *server) developer(r *http.Request, q query) (any, error) {
+	opts := gplay.DeveloperOptions{CallOptions: s.callOptions(), DevID: r.PathValue("id"), Lang: q.get("lang"), Country: q.get("country"), Num: q.int("num", 0), FullDetail: q.bool("fullDetail")}
+	if q.err != nil {
+		return nil, q.err
+	}
+	apps, err := s.client.Developer(r.Context(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return listBody{Data: apps}, nil
+}
+
+func (s *server) search(r *http.Request, q query) (any, error) {
+	opts := gplay.SearchOptions{
+		CallOptions:   s.callOptions(),
+		Term:          q.required("term"),
+		Num:           q.int("num", 0),
+		Lang:          q.get("lang"),
+		Country:       q.get("country"),
+		FullDetail:    q.bool("fullDetail"),
+		Price:         gplay.SearchPrice(q.get("price")),
+		Rating:        gplay.SearchRating(q.get("rating")),
+		ContentRating: gplay.SearchContentRating(q.get("contentRating")),
+		Device:        gplay.Device(q.get("device")),
+	}
+	if q.err != nil {
+		return nil, q.err
+	}
+	return s.client.SearchDetailed(r.Context(), opts)
+}
+
+func (s *server) list(r *http.Request, q query) (any, error) {
+	opts := gplay.ListOptions{
+		CallOptions: s.callOptions(),
+		Collection:  gplay.Collection(q.get("collection")),
+		Category:    gplay.Category(q.get("category")),
+		Device:      gplay.Device(q.get("device")),
+		Num:         q.int("num", 0),
+		Lang:        q.get("lang"),
+		Country:     q.get("country"),
+		FullDetail:  q.bool("fullDetail"),
+	}
+	if age := q.get("age"); age != "" {
+		a := gplay.Age(age)
+		opts.Age = &a
+	}
+	if q.err != nil {
+		return nil, q.err
+	}
+	apps, err := s.client.List(r.Context(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return listBody{Data: apps}, nil
+}
+
+func (s *server) suggest(r *http.Request, q query) (any, error) {
+	opts := gplay.SuggestOptions{CallOptions: s.callOptions(), Term: q.required("term"), Lang: q.get("lang"), Country: q.get("country")}
+	if q.err != nil {
+		return nil, q.err
+	}
+	sugs, err := s.client.SuggestDetailed(r.Context(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return listBody{Data: sugs}, nil
+}
+
+func (s *server) categories(r *http.Request, q query) (any, error) {
+	cats, err := s.client.Categories(r.Context(), gplay.CategoriesOptions{CallOptions: s.callOptions()})
+	if err != nil {
+		return nil, err
+	}
+	return listBody{Data: cats}, nil
+}
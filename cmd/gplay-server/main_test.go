@@ -0,0 +1,140 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+)
+
+func newTestServer(t *testing.T, upstream http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	play := httptest.NewServer(upstream)
+	t.Cleanup(play.Close)
+	client, err := gplay.NewClient(gplay.ClientOptions{BaseURL: play.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer((&server{client: client, throttle: 100}).routes())
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func getJSON(t *testing.T, srv *httptest.Server, path string, v any) int {
+	t.Helper()
+	resp, err := http.Get(srv.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("%s: unexpected content type %q", path, resp.Header.Get("Content-Type"))
+	}
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestRouting(t *testing.T) {
+	var upstreamCalls atomic.Int32
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		upstreamCalls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	var doc map[string]any
+	if status := getJSON(t, srv, "/openapi.json", &doc); status != http.StatusOK || doc["openapi"] == nil {
+		t.Fatalf("unexpected openapi response %d %v", status, doc)
+	}
+
+	for _, path := range []string{"/", "/nope", "/apps/com.a/unknown"} {
+		var body errorBody
+		if status := getJSON(t, srv, path, &body); status != http.StatusNotFound || body.Error.Status != http.StatusNotFound {
+			t.Errorf("%s: expected 404, got %d %+v", path, status, body)
+		}
+	}
+	if upstreamCalls.Load() != 0 {
+		t.Fatalf("unknown routes must not reach upstream, got %d calls", upstreamCalls.Load())
+	}
+}
+
+func TestValidation(t *testing.T) {
+	var upstreamCalls atomic.Int32
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		upstreamCalls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	cases := map[string]string{
+		"/search":                          "term missing",
+		"/search?term=maps&num=lots":       `invalid num "lots"`,
+		"/search?term=maps&num=500":        "num",
+		"/search?term=maps&rating=9":       "rating",
+		"/suggest":                         "term missing",
+		"/apps/com.a/reviews?sort=random":  "invalid sort random",
+		"/apps/com.a/similar?fullDetail=x": `invalid fullDetail "x"`,
+		"/list?collection=NOPE":            "collection",
+		"/list?device=toaster":             "device",
+	}
+	for path, msg := range cases {
+		var body errorBody
+		status := getJSON(t, srv, path, &body)
+		if status != http.StatusBadRequest || body.Error.Status != http.StatusBadRequest || !strings.Contains(body.Error.Message, msg) {
+			t.Errorf("%s: expected 400 mentioning %q, got %d %+v", path, msg, status, body)
+		}
+	}
+	if upstreamCalls.Load() != 0 {
+		t.Fatalf("invalid requests must not reach upstream, got %d calls", upstreamCalls.Load())
+	}
+}
+
+func TestUpstreamNotFound(t *testing.T) {
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var body errorBody
+	status := getJSON(t, srv, "/apps/com.missing", &body)
+	if status != http.StatusNotFound || body.Error.UpstreamStatus != http.StatusNotFound {
+		t.Fatalf("expected upstream 404 passthrough, got %d %+v", status, body)
+	}
+}
+
+func TestReviewsPaginationToken(t *testing.T) {
+	var gotBody string
+	srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotBody, _ = url.QueryUnescape(string(b))
+		inner, _ := json.Marshal([]any{
+			[]any{[]any{"r1", []any{"Jane"}, 5}},
+			[]any{nil, "page-3"},
+		})
+		outer, _ := json.Marshal([]any{[]any{"wrb.fr", "UsvDTd", string(inner)}})
+		fmt.Fprintf(w, ")]}'\n%s", outer)
+	})
+
+	var body struct {
+		Data                []gplay.Review `json:"data"`
+		NextPaginationToken *string        `json:"nextPaginationToken"`
+	}
+	status := getJSON(t, srv, "/apps/com.a/reviews?token=page-2&num=10", &body)
+	if status != http.StatusOK {
+		t.Fatalf("unexpected status %d", status)
+	}
+	if !strings.Contains(gotBody, `\"page-2\"`) || !strings.Contains(gotBody, `\"com.a\"`) {
+		t.Fatalf("token not forwarded upstream: %s", gotBody)
+	}
+	if len(body.Data) != 1 || body.Data[0].ID != "r1" || body.NextPaginationToken == nil || *body.NextPaginationToken != "page-3" {
+		t.Fatalf("unexpected reviews page %+v", body)
+	}
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+)
+
+type duration struct{ time.Duration }
+
+func (d *duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+type webhookConfig struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Retries *int              `json:"retries"`
+	Timeout duration          `json:"timeout"`
+}
+
+type watchedApp struct {
+	AppID       string `json:"appId"`
+	Lang        string `json:"lang"`
+	Country     string `json:"country"`
+	Permissions bool   `json:"permissions"`
+	DataSafety  bool   `json:"dataSafety"`
+}
+
+type config struct {
+	Interval    duration        `json:"interval"`
+	Throttle    int             `json:"throttle"`
+	SnapshotDir string          `json:"snapshotDir"`
+	DeadLetter  string          `json:"deadLetter"`
+	Stdout      bool            `json:"stdout"`
+	Webhooks    []webhookConfig `json:"webhooks"`
+	Apps        []watchedApp    `json:"apps"`
+}
+
+type event struct {
+	AppID     string              `json:"appId"`
+	Title     string              `json:"title"`
+	Lang      string              `json:"lang"`
+	Country   string              `json:"country"`
+	CheckedAt time.Time           `json:"checkedAt"`
+	Changes   []gplay.ChangeEvent `json:"changes"`
+}
+
+type deadLetter struct {
+	Webhook  string          `json:"webhook"`
+	Error    string          `json:"error"`
+	FailedAt time.Time       `json:"failedAt"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+type watcher struct {
+	cfg     config
+	client  *gplay.Client
+	store   gplay.SnapshotStore
+	http    *http.Client
+	backoff time.Duration
+	mu      sync.Mutex
+}
+
+func main() {
+	configPath := flag.String("config", "watchlist.json", "path to the watchlist config")
+	once := flag.Bool("once", false, "poll every app once and exit")
+	flag.Parse()
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		fatal(err)
+	}
+
+	client, err := gplay.NewClient(gplay.ClientOptions{Timeout: 25 * time.Second, RetryCount: 2, RetryWait: 700 * time.Millisecond})
+	if err != nil {
+		fatal(err)
+	}
+	w := &watcher{
+		cfg:     cfg,
+		client:  client,
+		store:   &gplay.FileSnapshotStore{Dir: cfg.SnapshotDir},
+		http:    &http.Client{},
+		backoff: time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	w.poll(ctx)
+	if *once {
+		return
+	}
+	ticker := time.NewTicker(cfg.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.poll(ctx)
+		}
+	}
+}
+
+func loadConfig(path string) (config, error) {
+	var cfg config
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return cfg, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(cfg.Apps) == 0 {
+		return cfg, errors.New("watchlist has no apps")
+	}
+	for _, a := range cfg.Apps {
+		if a.AppID == "" {
+			return cfg, errors.New("watchlist entry without appId")
+		}
+	}
+	if cfg.Interval.Duration == 0 {
+		cfg.Interval.Duration = time.Hour
+	}
+	if cfg.Throttle < 1 {
+		cfg.Throttle = 1
+	}
+	if cfg.SnapshotDir == "" {
+		cfg.SnapshotDir = "snapshots"
+	}
+	if cfg.DeadLetter == "" {
+		cfg.DeadLetter = "dead-letter.ndjson"
+	}
+	if len(cfg.Webhooks) == 0 {
+		cfg.Stdout = true
+	}
+	for i := range cfg.Webhooks {
+		if cfg.Webhooks[i].URL == "" {
+			return cfg, errors.New("webhook without url")
+		}
+		if cfg.Webhooks[i].Retries == nil {
+			retries := 3
+			cfg.Webhooks[i].Retries = &retries
+		}
+		if *cfg.Webhooks[i].Retries < 0 {
+			return cfg, errors.New("webhook retries can't be negative")
+		}
+		if cfg.Webhooks[i].Timeout.Duration == 0 {
+			cfg.Webhooks[i].Timeout.Duration = 10 * time.Second
+		}
+	}
+	return cfg, nil
+}
+
+func (w *watcher) poll(ctx context.Context) {
+	callOpts := gplay.CallOptions{Throttle: w.cfg.Throttle}
+	for _, a := range w.cfg.Apps {
+		if ctx.Err() != nil {
+			return
+		}
+		res, err := w.client.Snapshot(ctx, gplay.SnapshotOptions{
+			CallOptions: callOpts,
+			AppID:       a.AppID,
+			Lang:        a.Lang,
+			Country:     a.Country,
+			Permissions: a.Permissions,
+			DataSafety:  a.DataSafety,
+			Store:       w.store,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", a.AppID, err)
+			continue
+		}
+		if len(res.Changes) == 0 {
+			continue
+		}
+		w.deliver(ctx, event{
+			AppID:     res.Snapshot.AppID,
+			Title:     res.Snapshot.App.Title,
+			Lang:      res.Snapshot.Lang,
+			Country:   res.Snapshot.Country,
+			CheckedAt: res.Snapshot.TakenAt,
+			Changes:   res.Changes,
+		})
+	}
+}
+
+func (w *watcher) deliver(ctx context.Context, ev event) {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", ev.AppID, err)
+		return
+	}
+	if w.cfg.Stdout {
+		fmt.Println(string(payload))
+	}
+	for _, hook := range w.cfg.Webhooks {
+		if err := w.post(ctx, hook, payload); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: webhook %s: %v\n", ev.AppID, hook.URL, err)
+			if err := w.writeDeadLetter(deadLetter{Webhook: hook.URL, Error: err.Error(), FailedAt: time.Now().UTC(), Payload: payload}); err != nil {
+				fmt.Fprintf(os.Stderr, "dead letter: %v\n", err)
+			}
+		}
+	}
+}
+
+func (w *watcher) post(ctx context.Context, hook webhookConfig, payload []byte) error {
+	var lastErr error
+	wait := w.backoff
+	for attempt := 0; attempt <= *hook.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(wait):
+			}
+			wait *= 2
+		}
+		lastErr = w.postOnce(ctx, hook, payload)
+		if lastErr == nil {
+			return nil
+		}
+	}
+	return lastErr
+}
+
+func (w *watcher) postOnce(ctx context.Context, hook webhookConfig, payload []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, hook.Timeout.Duration)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range hook.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (w *watcher) writeDeadLetter(dl deadLetter) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	f, err := os.OpenFile(w.cfg.DeadLetter, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(f).Encode(dl); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+	os.Exit(1)
+}
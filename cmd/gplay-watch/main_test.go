@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+)
+
+func writeConfig(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "watchlist.json")
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	cfg, err := loadConfig(writeConfig(t, `{"apps":[{"appId":"com.a"}],"webhooks":[{"url":"http://x"},{"url":"http://y","retries":0,"timeout":"2s"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Interval.Duration != time.Hour || cfg.Throttle != 1 || cfg.SnapshotDir != "snapshots" || cfg.DeadLetter != "dead-letter.ndjson" || cfg.Stdout {
+		t.Fatalf("unexpected defaults %+v", cfg)
+	}
+	if *cfg.Webhooks[0].Retries != 3 || cfg.Webhooks[0].Timeout.Duration != 10*time.Second {
+		t.Fatalf("unexpected webhook defaults %+v", cfg.Webhooks[0])
+	}
+	if *cfg.Webhooks[1].Retries != 0 || cfg.Webhooks[1].Timeout.Duration != 2*time.Second {
+		t.Fatalf("explicit webhook settings not kept %+v", cfg.Webhooks[1])
+	}
+
+	cfg, err = loadConfig(writeConfig(t, `{"apps":[{"appId":"com.a"}]}`))
+	if err != nil || !cfg.Stdout {
+		t.Fatalf("expected stdout delivery without webhooks, got %+v %v", cfg, err)
+	}
+
+	for _, body := range []string{
+		`{"apps":[]}`,
+		`{"apps":[{"lang":"en"}]}`,
+		`{"apps":[{"appId":"com.a"}],"webhooks":[{"retries":1}]}`,
+		`{"apps":[{"appId":"com.a"}],"webhooks":[{"url":"http://x","retries":-1}]}`,
+		`{"apps":[{"appId":"com.a"}],"interval":"soon"}`,
+	} {
+		if _, err := loadConfig(writeConfig(t, body)); err == nil {
+			t.Errorf("expected error for %s", body)
+		}
+	}
+}
+
+func intPtr(v int) *int { return &v }
+
+func TestPostRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token") != "secret" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := &watcher{http: srv.Client(), backoff: time.Millisecond}
+	hook := webhookConfig{URL: srv.URL, Headers: map[string]string{"X-Token": "secret"}, Retries: intPtr(2), Timeout: duration{time.Second}}
+	if err := w.post(context.Background(), hook, []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls.Load())
+	}
+
+	calls.Store(0)
+	hook.Retries = intPtr(0)
+	if err := w.post(context.Background(), hook, []byte(`{}`)); err == nil || !strings.Contains(err.Error(), "503") {
+		t.Fatalf("expected status error, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single attempt with zero retries, got %d", calls.Load())
+	}
+}
+
+func TestPostCancelledDuringBackoff(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	w := &watcher{http: srv.Client(), backoff: time.Hour}
+	start := time.Now()
+	err := w.post(ctx, webhookConfig{URL: srv.URL, Retries: intPtr(5), Timeout: duration{time.Second}}, []byte(`{}`))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+	if calls.Load() != 1 || time.Since(start) > 5*time.Second {
+		t.Fatalf("expected to stop during backoff, got %d calls after %v", calls.Load(), time.Since(start))
+	}
+}
+
+func TestWriteDeadLetter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dead.ndjson")
+	w := &watcher{cfg: config{DeadLetter: path}}
+	for _, hook := range []string{"http://a", "http://b"} {
+		if err := w.writeDeadLetter(deadLetter{Webhook: hook, Error: "boom", Payload: json.RawMessage(`{"appId":"com.a"}`)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 dead letters, got %q", b)
+	}
+	var dl deadLetter
+	if err := json.Unmarshal([]byte(lines[1]), &dl); err != nil {
+		t.Fatal(err)
+	}
+	if dl.Webhook != "http://b" || string(dl.Payload) != `{"appId":"com.a"}` {
+		t.Fatalf("unexpected dead letter %+v", dl)
+	}
+}
+
+func detailsPage(title, version string) string {
+	details := make([]any, 141)
+	details[0] = []any{title}
+	details[140] = []any{[]any{[]any{version}}}
+	data, _ := json.Marshal([]any{nil, []any{nil, nil, details}})
+	return fmt.Sprintf("<html><script>AF_initDataCallback({key: 'ds:5', hash: '1', data:%s, sideChannel: {}});</script></html>", data)
+}
+
+func TestPollKeepsMarketsApart(t *testing.T) {
+	store := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("gl") == "de" {
+			fmt.Fprint(w, detailsPage("App DE", "2.0"))
+			return
+		}
+		fmt.Fprint(w, detailsPage("App US", "1.0"))
+	}))
+	defer store.Close()
+	var deliveries atomic.Int32
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveries.Add(1)
+	}))
+	defer hook.Close()
+
+	client, err := gplay.NewClient(gplay.ClientOptions{BaseURL: store.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &watcher{
+		cfg: config{
+			Throttle:   100,
+			DeadLetter: filepath.Join(t.TempDir(), "dead.ndjson"),
+			Webhooks:   []webhookConfig{{URL: hook.URL, Retries: intPtr(0), Timeout: duration{time.Second}}},
+			Apps:       []watchedApp{{AppID: "com.a", Country: "us"}, {AppID: "com.a", Country: "de"}},
+		},
+		client: client,
+		store:  &gplay.MemorySnapshotStore{},
+		http:   hook.Client(),
+	}
+	for i := 0; i < 3; i++ {
+		w.poll(context.Background())
+	}
+	if deliveries.Load() != 0 {
+		t.Fatalf("expected no change events, got %d deliveries", deliveries.Load())
+	}
+	for _, country := range []string{"us", "de"} {
+		snaps, err := w.store.Snapshots(context.Background(), gplay.SnapshotKey{AppID: "com.a", Lang: "en", Country: country})
+		if err != nil || len(snaps) != 3 {
+			t.Fatalf("%s: expected 3 snapshots, got %d %v", country, len(snaps), err)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+	"github.com/facundoolano/google-play-scraper-go/sqlite"
+)
+
+type command struct {
+	usage   string
+	columns []string
+	setup   func(fs *flag.FlagSet, cf *commonFlags) runFunc
+}
+
+type runFunc func(ctx context.Context, c *gplay.Client) (any, error)
+
+type commonFlags struct {
+	lang     string
+	country  string
+	throttle int
+	format   string
+	columns  string
+	timeout  time.Duration
+	sqlite   string
+}
+
+var appColumns = []string{"appId", "title", "developer", "score", "installs", "priceText", "url"}
+
+var commands = map[string]command{
+	"app": {
+		usage:   "app [flags] <appId>",
+		columns: []string{"appId", "title", "developer", "genre", "score", "ratings", "installs", "priceText", "version", "updated"},
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.App(ctx, gplay.AppOptions{CallOptions: cf.callOptions(), AppID: fs.Arg(0), Lang: cf.lang, Country: cf.country})
+			}
+		},
+	},
+	"search": {
+		usage:   "search [flags] <term>",
+		columns: appColumns,
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			num := fs.Int("num", 20, "number of results (max 250)")
+			full := fs.Bool("full", false, "fetch full app details")
+			price := fs.String("price", "all", "price filter: all, free or paid")
+			rating := fs.String("rating", "", "minimum rating filter: 4+")
+			content := fs.String("content-rating", "", "content rating: everyone, teen or mature")
+			device := fs.String("device", "", "device form factor")
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.Search(ctx, gplay.SearchOptions{
+					CallOptions:   cf.callOptions(),
+					Term:          strings.Join(fs.Args(), " "),
+					Num:           *num,
+					Lang:          cf.lang,
+					Country:       cf.country,
+					FullDetail:    *full,
+					Price:         gplay.SearchPrice(*price),
+					Rating:        gplay.SearchRating(*rating),
+					ContentRating: gplay.SearchContentRating(*content),
+					Device:        gplay.Device(*device),
+				})
+			}
+		},
+	},
+	"list": {
+		usage:   "list [flags]",
+		columns: appColumns,
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			collection := fs.String("collection", string(gplay.CollectionTopFree), "collection")
+			category := fs.String("category", string(gplay.CategoryApplication), "category")
+			device := fs.String("device", "", "device form factor")
+			age := fs.String("age", "", "age range for family categories")
+			num := fs.Int("num", 500, "number of results")
+			full := fs.Bool("full", false, "fetch full app details")
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				opts := gplay.ListOptions{
+					CallOptions: cf.callOptions(),
+					Collection:  gplay.Collection(*collection),
+					Category:    gplay.Category(*category),
+					Device:      gplay.Device(*device),
+					Num:         *num,
+					Lang:        cf.lang,
+					Country:     cf.country,
+					FullDetail:  *full,
+				}
+				if *age != "" {
+					a := gplay.Age(*age)
+					opts.Age = &a
+				}
+				return c.List(ctx, opts)
+			}
+		},
+	},
+	"developer": {
+		usage:   "developer [flags] <devId>",
+		columns: appColumns,
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			num := fs.Int("num", 60, "number of results")
+			full := fs.Bool("full", false, "fetch full app details")
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.Developer(ctx, gplay.DeveloperOptions{CallOptions: cf.callOptions(), DevID: strings.Join(fs.Args(), " "), Num: *num, Lang: cf.lang, Country: cf.country, FullDetail: *full})
+			}
+		},
+	},
+	"suggest": {
+		usage:   "suggest [flags] <term>",
+		columns: []string{"position", "term", "kind", "appId"},
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.SuggestDetailed(ctx, gplay.SuggestOptions{CallOptions: cf.callOptions(), Term: strings.Join(fs.Args(), " "), Lang: cf.lang, Country: cf.country})
+			}
+		},
+	},
+	"reviews": {
+		usage:   "reviews [flags] <appId>",
+		columns: []string{"id", "userName", "date", "score", "version", "thumbsUp", "text"},
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			sortBy := fs.String("sort", "newest", "sort order: newest, rating or helpfulness")
+			num := fs.Int("num", 150, "number of reviews")
+			paginate := fs.Bool("paginate", false, "return a single page and its pagination token")
+			token := fs.String("token", "", "pagination token from a previous page")
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				sorts := map[string]gplay.Sort{"newest": gplay.SortNewest, "rating": gplay.SortRating, "helpfulness": gplay.SortHelpfulness}
+				s, ok := sorts[*sortBy]
+				if !ok {
+					return nil, errors.New("invalid sort " + *sortBy)
+				}
+				opts := gplay.ReviewsOptions{CallOptions: cf.callOptions(), AppID: fs.Arg(0), Lang: cf.lang, Country: cf.country, Sort: s, Num: *num, Paginate: *paginate}
+				if *token != "" {
+					opts.NextPaginationToken = token
+				}
+				return c.Reviews(ctx, opts)
+			}
+		},
+	},
+	"similar": {
+		usage:   "similar [flags] <appId>",
+		columns: appColumns,
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			num := fs.Int("num", 60, "number of results")
+			full := fs.Bool("full", false, "fetch full app details")
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.Similar(ctx, gplay.SimilarOptions{CallOptions: cf.callOptions(), AppID: fs.Arg(0), Lang: cf.lang, Country: cf.country, FullDetail: *full, Num: *num})
+			}
+		},
+	},
+	"permissions": {
+		usage:   "permissions [flags] <appId>",
+		columns: []string{"type", "permission"},
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			short := fs.Bool("short", false, "only return permission names")
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.Permissions(ctx, gplay.PermissionsOptions{CallOptions: cf.callOptions(), AppID: fs.Arg(0), Lang: cf.lang, Country: cf.country, Short: *short})
+			}
+		},
+	},
+	"datasafety": {
+		usage:   "datasafety [flags] <appId>",
+		columns: []string{"section", "type", "data", "purpose", "optional"},
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.DataSafety(ctx, gplay.DataSafetyOptions{CallOptions: cf.callOptions(), AppID: fs.Arg(0), Lang: cf.lang})
+			}
+		},
+	},
+	"categories": {
+		usage:   "categories [flags]",
+		columns: []string{"category"},
+		setup: func(fs *flag.FlagSet, cf *commonFlags) runFunc {
+			return func(ctx context.Context, c *gplay.Client) (any, error) {
+				return c.Categories(ctx, gplay.CategoriesOptions{CallOptions: cf.callOptions()})
+			}
+		},
+	},
+}
+
+func (cf *commonFlags) callOptions() gplay.CallOptions {
+	return gplay.CallOptions{Throttle: cf.throttle}
+}
+
+type invocation struct {
+	name  string
+	cmd   command
+	fs    *flag.FlagSet
+	flags *commonFlags
+	run   runFunc
+}
+
+var errUnknownCommand = errors.New("unknown command")
+
+func parseArgs(args []string, errorHandling flag.ErrorHandling) (invocation, error) {
+	if len(args) == 0 {
+		return invocation{}, errUnknownCommand
+	}
+	name := args[0]
+	cmd, ok := commands[name]
+	if !ok {
+		return invocation{}, fmt.Errorf("%w %s", errUnknownCommand, name)
+	}
+
+	fs := flag.NewFlagSet(name, errorHandling)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: gplay "+cmd.usage)
+		fs.PrintDefaults()
+	}
+	cf := &commonFlags{}
+	fs.StringVar(&cf.lang, "lang", "en", "language code")
+	fs.StringVar(&cf.country, "country", "us", "country code")
+	fs.IntVar(&cf.throttle, "throttle", 1, "maximum requests per second")
+	fs.StringVar(&cf.format, "format", "json", "output format: json, ndjson, csv or table")
+	fs.StringVar(&cf.columns, "columns", "", "comma separated output columns, or all for every column")
+	fs.StringVar(&cf.sqlite, "sqlite", "", "also store results in this SQLite database")
+	fs.DurationVar(&cf.timeout, "timeout", 5*time.Minute, "overall timeout")
+
+	run := cmd.setup(fs, cf)
+	if err := fs.Parse(args[1:]); err != nil {
+		return invocation{}, err
+	}
+	switch cf.format {
+	case "json", "ndjson", "csv", "table":
+	default:
+		return invocation{}, errors.New("unknown format " + cf.format)
+	}
+	return invocation{name: name, cmd: cmd, fs: fs, flags: cf, run: run}, nil
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-h" || os.Args[1] == "--help" || os.Args[1] == "help" {
+		usage()
+		os.Exit(2)
+	}
+	inv, err := parseArgs(os.Args[1:], flag.ExitOnError)
+	if errors.Is(err, errUnknownCommand) {
+		fmt.Fprintln(os.Stderr, err.Error())
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fatal(err)
+	}
+	cf := inv.flags
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if cf.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cf.timeout)
+		defer cancel()
+	}
+
+	client, err := gplay.NewClient(gplay.ClientOptions{Timeout: 25 * time.Second, RetryCount: 2, RetryWait: 700 * time.Millisecond})
+	if err != nil {
+		fatal(err)
+	}
+	result, err := inv.run(ctx, client)
+	if err != nil {
+		fatal(err)
+	}
+	if cf.sqlite != "" {
+		if err := store(ctx, cf.sqlite, inv.fs.Arg(0), result); err != nil {
+			fatal(err)
+		}
+	}
+
+	var columns []string
+	if cf.columns != "" {
+		columns = strings.Split(cf.columns, ",")
+	}
+	if err := write(os.Stdout, cf.format, columns, inv.cmd.columns, result); err != nil {
+		fatal(err)
+	}
+	if token := pageToken(result); token != nil && cf.format != "json" {
+		fmt.Fprintln(os.Stderr, "next token:", *token)
+	}
+}
+
+func store(ctx context.Context, path, appID string, result any) error {
+	sink, err := sqlite.Open(path)
+	if err != nil {
+		return err
+	}
+	defer sink.Close()
+
+	switch t := result.(type) {
+	case gplay.App:
+		return sink.WriteApp(ctx, t)
+	case []gplay.App:
+		for _, a := range t {
+			if err := sink.WriteApp(ctx, a); err != nil {
+				return err
+			}
+		}
+		return nil
+	case gplay.ReviewsResult:
+		return sink.WriteReviews(ctx, appID, t.Data)
+	case gplay.PermissionsResult:
+		return sink.WritePermissions(ctx, appID, t)
+	case gplay.DataSafetyResult:
+		return sink.WriteDataSafety(ctx, appID, t)
+	default:
+		return fmt.Errorf("cannot store %T results in SQLite", result)
+	}
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for n := range commands {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	fmt.Fprintln(os.Stderr, "usage: gplay <command> [flags] [args]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, n := range names {
+		fmt.Fprintln(os.Stderr, "  "+commands[n].usage)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+	os.Exit(1)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+)
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		args    []string
+		name    string
+		format  string
+		lang    string
+		arg     string
+		wantErr string
+	}{
+		{args: []string{"app", "com.a"}, name: "app", format: "json", lang: "en", arg: "com.a"},
+		{args: []string{"search", "-format", "csv", "-lang", "de", "maps"}, name: "search", format: "csv", lang: "de", arg: "maps"},
+		{args: []string{"reviews", "-format=table", "-sort", "rating", "com.a"}, name: "reviews", format: "table", lang: "en", arg: "com.a"},
+		{args: []string{"list", "-format", "ndjson", "-collection", "TOP_PAID"}, name: "list", format: "ndjson", lang: "en"},
+		{args: []string{"categories"}, name: "categories", format: "json", lang: "en"},
+		{args: []string{"app", "-format", "xml", "com.a"}, wantErr: "unknown format xml"},
+		{args: []string{"app", "-bogus", "com.a"}, wantErr: "flag provided but not defined"},
+		{args: []string{"fetch", "com.a"}, wantErr: "unknown command fetch"},
+		{args: nil, wantErr: "unknown command"},
+	}
+	for _, tc := range cases {
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			inv, err := parseArgs(tc.args, flag.ContinueOnError)
+			if inv.fs != nil {
+				inv.fs.SetOutput(io.Discard)
+			}
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inv.name != tc.name || inv.flags.format != tc.format || inv.flags.lang != tc.lang || inv.fs.Arg(0) != tc.arg || inv.run == nil {
+				t.Fatalf("unexpected invocation %s %+v arg=%q", inv.name, inv.flags, inv.fs.Arg(0))
+			}
+		})
+	}
+
+	if _, err := parseArgs([]string{"nope"}, flag.ContinueOnError); !errors.Is(err, errUnknownCommand) {
+		t.Fatalf("expected errUnknownCommand, got %v", err)
+	}
+}
+
+func TestWriteFormats(t *testing.T) {
+	score := 4.5
+	apps := []gplay.App{
+		{AppID: "com.a", Title: "A", Score: &score},
+		{AppID: "com.b", Title: "B"},
+	}
+	cols := []string{"appId", "title", "score"}
+
+	var buf bytes.Buffer
+	if err := write(&buf, "json", nil, cols, apps); err != nil {
+		t.Fatal(err)
+	}
+	var decoded []gplay.App
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil || len(decoded) != 2 {
+		t.Fatalf("unexpected json output %s %v", buf.String(), err)
+	}
+
+	buf.Reset()
+	if err := write(&buf, "ndjson", []string{"appId", "score"}, cols, apps); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "{\"appId\":\"com.a\",\"score\":4.5}\n{\"appId\":\"com.b\",\"score\":null}\n" {
+		t.Fatalf("unexpected ndjson output %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := write(&buf, "table", nil, cols, apps); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || strings.Join(strings.Fields(lines[0]), " ") != "APPID TITLE SCORE" || strings.Join(strings.Fields(lines[1]), " ") != "com.a A 4.5" {
+		t.Fatalf("unexpected table output\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := write(&buf, "table", nil, []string{"category"}, []string{"GAME", "TOOLS"}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(strings.Fields(buf.String()), " ") != "CATEGORY GAME TOOLS" {
+		t.Fatalf("unexpected categories table %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := write(&buf, "csv", nil, cols, apps); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "appId,title,score\ncom.a,A,4.5\ncom.b,B,\n" {
+		t.Fatalf("expected default csv columns, got %q", buf.String())
+	}
+
+	buf.Reset()
+	if err := write(&buf, "csv", []string{"all"}, cols, apps); err != nil {
+		t.Fatal(err)
+	}
+	header := strings.SplitN(buf.String(), "\n", 2)[0]
+	if header != strings.Join(gplay.Columns(gplay.App{}), ",") {
+		t.Fatalf("expected every column with --columns=all, got %q", header)
+	}
+
+	buf.Reset()
+	if err := write(&buf, "ndjson", nil, cols, apps[:1]); err != nil {
+		t.Fatal(err)
+	}
+	var row map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &row); err != nil || len(row) != len(gplay.Columns(gplay.App{})) {
+		t.Fatalf("expected ndjson to default to every column, got %q %v", buf.String(), err)
+	}
+
+	if err := write(&buf, "yaml", nil, cols, apps); err == nil {
+		t.Fatal("expected unknown format error")
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	token := "next"
+	res := gplay.ReviewsResult{Data: []gplay.Review{{ID: "r1"}}, NextPaginationToken: &token}
+	if got := pageToken(res); got == nil || *got != "next" {
+		t.Fatalf("unexpected token %v", got)
+	}
+	if pageToken([]gplay.App{}) != nil {
+		t.Fatal("expected no token for app lists")
+	}
+	if rows, ok := tabular(res).([]gplay.Review); !ok || len(rows) != 1 {
+		t.Fatalf("unexpected review rows %#v", tabular(res))
+	}
+}
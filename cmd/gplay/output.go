@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+)
+
+type permissionName struct {
+	Permission string `json:"permission"`
+}
+
+type dataSafetyRow struct {
+	Section string `json:"section"`
+	gplay.DataSafetyEntry
+}
+
+type categoryRow struct {
+	Category string `json:"category"`
+}
+
+func tabular(v any) any {
+	switch t := v.(type) {
+	case gplay.App:
+		return []gplay.App{t}
+	case gplay.ReviewsResult:
+		return t.Data
+	case gplay.PermissionsResult:
+		if !t.Short {
+			return t.Items
+		}
+		rows := make([]permissionName, 0, len(t.Names))
+		for _, n := range t.Names {
+			rows = append(rows, permissionName{Permission: n})
+		}
+		return rows
+	case gplay.DataSafetyResult:
+		rows := make([]dataSafetyRow, 0, len(t.SharedData)+len(t.CollectedData))
+		sections := []struct {
+			name    string
+			entries []gplay.DataSafetyEntry
+		}{{"shared", t.SharedData}, {"collected", t.CollectedData}}
+		for _, sec := range sections {
+			for _, e := range sec.entries {
+				rows = append(rows, dataSafetyRow{Section: sec.name, DataSafetyEntry: e})
+			}
+		}
+		return rows
+	case []string:
+		rows := make([]categoryRow, 0, len(t))
+		for _, cat := range t {
+			rows = append(rows, categoryRow{Category: cat})
+		}
+		return rows
+	}
+	return v
+}
+
+func pageToken(v any) *string {
+	if res, ok := v.(gplay.ReviewsResult); ok {
+		return res.NextPaginationToken
+	}
+	return nil
+}
+
+func write(w io.Writer, format string, columns, defaultColumns []string, v any) error {
+	if format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	sample, records := toRecords(tabular(v))
+	columns = selectColumns(format, columns, defaultColumns, sample)
+	switch format {
+	case "ndjson":
+		nw, err := gplay.NewNDJSONWriter(w, sample, columns...)
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := nw.Write(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "csv":
+		cw, err := gplay.NewCSVWriter(w, sample, columns...)
+		if err != nil {
+			return err
+		}
+		if err := cw.WriteHeader(); err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := cw.Write(r); err != nil {
+				return err
+			}
+		}
+		return cw.Flush()
+	case "table":
+		var buf bytes.Buffer
+		cw, err := gplay.NewCSVWriter(&buf, sample, columns...)
+		if err != nil {
+			return err
+		}
+		if err := cw.WriteHeader(); err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := cw.Write(r); err != nil {
+				return err
+			}
+		}
+		if err := cw.Flush(); err != nil {
+			return err
+		}
+		lines, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for i, line := range lines {
+			for j, c := range line {
+				if i == 0 {
+					line[j] = strings.ToUpper(c)
+				} else {
+					line[j] = truncate(strings.Join(strings.Fields(c), " "), 60)
+				}
+			}
+			fmt.Fprintln(tw, strings.Join(line, "\t"))
+		}
+		return tw.Flush()
+	default:
+		return errors.New("unknown format " + format)
+	}
+}
+
+func toRecords(v any) (any, []any) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return v, []any{v}
+	}
+	records := make([]any, rv.Len())
+	for i := range records {
+		records[i] = rv.Index(i).Interface()
+	}
+	return reflect.Zero(rv.Type().Elem()).Interface(), records
+}
+
+func selectColumns(format string, columns, defaultColumns []string, sample any) []string {
+	switch {
+	case len(columns) == 1 && columns[0] == "all":
+		return nil
+	case len(columns) > 0:
+		return columns
+	case format == "ndjson":
+		return nil
+	default:
+		return knownColumns(sample, defaultColumns)
+	}
+}
+
+func knownColumns(sample any, preferred []string) []string {
+	all := gplay.Columns(sample)
+	known := make(map[string]bool, len(all))
+	for _, c := range all {
+		known[c] = true
+	}
+	out := make([]string, 0, len(preferred))
+	for _, c := range preferred {
+		if known[c] {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
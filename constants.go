@@ -10,6 +10,8 @@ type Sort int
 
 type Age string
 
+type Device string
+
 type PermissionGroup int
 
 const (
@@ -69,12 +71,94 @@ const (
 	CategoryFamily            Category = "FAMILY"
 )
 
+var AllCategories = []Category{
+	CategoryApplication,
+	CategoryAndroidWear,
+	CategoryArtAndDesign,
+	CategoryAutoAndVehicles,
+	CategoryBeauty,
+	CategoryBooksAndReference,
+	CategoryBusiness,
+	CategoryComics,
+	CategoryCommunication,
+	CategoryDating,
+	CategoryEducation,
+	CategoryEntertainment,
+	CategoryEvents,
+	CategoryFinance,
+	CategoryFoodAndDrink,
+	CategoryHealthAndFitness,
+	CategoryHouseAndHome,
+	CategoryLibrariesAndDemo,
+	CategoryLifestyle,
+	CategoryMapsAndNavigation,
+	CategoryMedical,
+	CategoryMusicAndAudio,
+	CategoryNewsAndMagazines,
+	CategoryParenting,
+	CategoryPersonalization,
+	CategoryPhotography,
+	CategoryProductivity,
+	CategoryShopping,
+	CategorySocial,
+	CategorySports,
+	CategoryTools,
+	CategoryTravelAndLocal,
+	CategoryVideoPlayers,
+	CategoryWatchFace,
+	CategoryWeather,
+	CategoryGame,
+	CategoryGameAction,
+	CategoryGameAdventure,
+	CategoryGameArcade,
+	CategoryGameBoard,
+	CategoryGameCard,
+	CategoryGameCasino,
+	CategoryGameCasual,
+	CategoryGameEducational,
+	CategoryGameMusic,
+	CategoryGamePuzzle,
+	CategoryGameRacing,
+	CategoryGameRolePlaying,
+	CategoryGameSimulation,
+	CategoryGameSports,
+	CategoryGameStrategy,
+	CategoryGameTrivia,
+	CategoryGameWord,
+	CategoryFamily,
+}
+
+const (
+	CollectionTopFree      Collection = "TOP_FREE"
+	CollectionTopPaid      Collection = "TOP_PAID"
+	CollectionGrossing     Collection = "GROSSING"
+	CollectionTopNewFree   Collection = "TOP_NEW_FREE"
+	CollectionTopNewPaid   Collection = "TOP_NEW_PAID"
+	CollectionTrending     Collection = "TRENDING"
+	CollectionTopPaidGames Collection = "TOP_PAID_GAMES"
+)
+
+var AllCollections = []Collection{
+	CollectionTopFree,
+	CollectionTopPaid,
+	CollectionGrossing,
+	CollectionTopNewFree,
+	CollectionTopNewPaid,
+	CollectionTrending,
+	CollectionTopPaidGames,
+}
+
 const (
-	CollectionTopFree  Collection = "TOP_FREE"
-	CollectionTopPaid  Collection = "TOP_PAID"
-	CollectionGrossing Collection = "GROSSING"
+	DevicePhone      Device = "phone"
+	DeviceTablet     Device = "tablet"
+	DeviceWear       Device = "watch"
+	DeviceTV         Device = "tv"
+	DeviceChromebook Device = "chromebook"
+	DeviceCar        Device = "car"
 )
 
+var AllDevices = []Device{DevicePhone, DeviceTablet, DeviceWear, DeviceTV, DeviceChromebook, DeviceCar}
+
 const (
 	SortHelpfulness Sort = 1
 	SortNewest      Sort = 2
@@ -87,6 +171,8 @@ const (
 	AgeNineUp    Age = "AGE_RANGE3"
 )
 
+var AllAges = []Age{AgeFiveUnder, AgeSixEight, AgeNineUp}
+
 const (
 	PermissionGroupCommon PermissionGroup = 0
 	PermissionGroupOther  PermissionGroup = 1
@@ -0,0 +1,283 @@
+package gplay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+type AppSink interface {
+	WriteApp(ctx context.Context, app App) error
+}
+
+type AppSinkFunc func(ctx context.Context, app App) error
+
+func (f AppSinkFunc) WriteApp(ctx context.Context, app App) error { return f(ctx, app) }
+
+type CrawlItem struct {
+	AppID string `json:"appId"`
+	Depth int    `json:"depth"`
+	Via   string `json:"via"`
+}
+
+type crawlState struct {
+	Frontier   []CrawlItem `json:"frontier"`
+	Seen       []string    `json:"seen"`
+	Developers []string    `json:"developers"`
+	Fetched    int         `json:"fetched"`
+	Failed     int         `json:"failed"`
+}
+
+type CrawlResult struct {
+	Fetched    int  `json:"fetched"`
+	Failed     int  `json:"failed"`
+	Discovered int  `json:"discovered"`
+	Remaining  int  `json:"remaining"`
+	Truncated  bool `json:"truncated"`
+}
+
+type crawlSource interface {
+	app(ctx context.Context, appID string) (App, error)
+	similar(ctx context.Context, appID string) ([]App, error)
+	developer(ctx context.Context, devID string) ([]App, error)
+	search(ctx context.Context, term string) ([]App, error)
+	list(ctx context.Context, collection Collection, category Category) ([]App, error)
+}
+
+type clientCrawlSource struct {
+	c    *Client
+	opts CrawlOptions
+}
+
+func (s clientCrawlSource) app(ctx context.Context, appID string) (App, error) {
+	return s.c.App(ctx, AppOptions{CallOptions: s.opts.CallOptions, AppID: appID, Lang: s.opts.Lang, Country: s.opts.Country})
+}
+
+func (s clientCrawlSource) similar(ctx context.Context, appID string) ([]App, error) {
+	return s.c.Similar(ctx, SimilarOptions{CallOptions: s.opts.CallOptions, AppID: appID, Lang: s.opts.Lang, Country: s.opts.Country})
+}
+
+func (s clientCrawlSource) developer(ctx context.Context, devID string) ([]App, error) {
+	return s.c.Developer(ctx, DeveloperOptions{CallOptions: s.opts.CallOptions, DevID: devID, Lang: s.opts.Lang, Country: s.opts.Country})
+}
+
+func (s clientCrawlSource) search(ctx context.Context, term string) ([]App, error) {
+	return s.c.Search(ctx, SearchOptions{CallOptions: s.opts.CallOptions, Term: term, Num: s.opts.SearchNum, Lang: s.opts.Lang, Country: s.opts.Country})
+}
+
+func (s clientCrawlSource) list(ctx context.Context, collection Collection, category Category) ([]App, error) {
+	return s.c.List(ctx, ListOptions{CallOptions: s.opts.CallOptions, Collection: collection, Category: category, Num: s.opts.ListNum, Lang: s.opts.Lang, Country: s.opts.Country})
+}
+
+func (c *Client) Crawl(ctx context.Context, opts CrawlOptions) (CrawlResult, error) {
+	if opts.Lang == "" {
+		opts.Lang = "en"
+	}
+	if opts.Country == "" {
+		opts.Country = "us"
+	}
+	if opts.SearchNum == 0 {
+		opts.SearchNum = 50
+	}
+	if opts.ListNum == 0 {
+		opts.ListNum = 100
+	}
+	return crawl(ctx, clientCrawlSource{c: c, opts: opts}, opts)
+}
+
+func crawl(ctx context.Context, src crawlSource, opts CrawlOptions) (CrawlResult, error) {
+	if opts.Sink == nil {
+		return CrawlResult{}, optionError("sink missing")
+	}
+	maxDepth := opts.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = 2
+	}
+	budget := opts.Budget
+	if budget == 0 {
+		budget = 1000
+	}
+	checkpointEvery := opts.CheckpointEvery
+	if checkpointEvery == 0 {
+		checkpointEvery = 25
+	}
+
+	state, resumed, err := loadCrawlState(opts.Checkpoint)
+	if err != nil {
+		return CrawlResult{}, err
+	}
+	seen := make(map[string]bool, len(state.Seen))
+	for _, id := range state.Seen {
+		seen[id] = true
+	}
+	devs := make(map[string]bool, len(state.Developers))
+	for _, id := range state.Developers {
+		devs[id] = true
+	}
+	enqueue := func(appID string, depth int, via string) {
+		if appID == "" || seen[appID] {
+			return
+		}
+		seen[appID] = true
+		state.Frontier = append(state.Frontier, CrawlItem{AppID: appID, Depth: depth, Via: via})
+	}
+	save := func() error {
+		if opts.Checkpoint == "" {
+			return nil
+		}
+		state.Seen = sortedKeys(seen)
+		state.Developers = sortedKeys(devs)
+		return saveCrawlState(opts.Checkpoint, state)
+	}
+
+	if !resumed {
+		for _, id := range opts.SeedApps {
+			enqueue(id, 0, "seed")
+		}
+		for _, term := range opts.SeedTerms {
+			apps, err := src.search(ctx, term)
+			if err != nil {
+				return CrawlResult{}, err
+			}
+			for _, a := range apps {
+				enqueue(a.AppID, 0, "search:"+term)
+			}
+		}
+		collections := opts.Collections
+		if len(collections) == 0 {
+			collections = []Collection{CollectionTopFree}
+		}
+		for _, cat := range opts.SeedCategories {
+			for _, col := range collections {
+				apps, err := src.list(ctx, col, cat)
+				if err != nil {
+					return CrawlResult{}, err
+				}
+				for _, a := range apps {
+					enqueue(a.AppID, 0, "list:"+string(col)+"/"+string(cat))
+				}
+			}
+		}
+		if err := save(); err != nil {
+			return CrawlResult{}, err
+		}
+	}
+
+	result := func() CrawlResult {
+		return CrawlResult{Fetched: state.Fetched, Failed: state.Failed, Discovered: len(seen), Remaining: len(state.Frontier), Truncated: len(state.Frontier) > 0}
+	}
+	fail := func(err error) (CrawlResult, error) {
+		if saveErr := save(); saveErr != nil {
+			return result(), errors.Join(err, saveErr)
+		}
+		return result(), err
+	}
+
+	sinceCheckpoint := 0
+	for len(state.Frontier) > 0 && state.Fetched < budget {
+		if err := ctx.Err(); err != nil {
+			return fail(err)
+		}
+		item := state.Frontier[0]
+
+		app, err := src.app(ctx, item.AppID)
+		if err != nil && !isNotFound(err) {
+			return fail(err)
+		}
+		if err != nil {
+			state.Failed++
+			state.Frontier = state.Frontier[1:]
+			continue
+		}
+		if err := opts.Sink.WriteApp(ctx, app); err != nil {
+			return fail(err)
+		}
+
+		if item.Depth < maxDepth {
+			if !opts.SkipSimilar {
+				similar, err := src.similar(ctx, item.AppID)
+				if err != nil && !isNotFound(err) && !errors.Is(err, ErrSimilarNotFound) {
+					return fail(err)
+				}
+				for _, a := range similar {
+					enqueue(a.AppID, item.Depth+1, "similar:"+item.AppID)
+				}
+			}
+			if !opts.SkipDeveloper && app.DeveloperID != "" && !devs[app.DeveloperID] {
+				devApps, err := src.developer(ctx, app.DeveloperID)
+				if err != nil && !isNotFound(err) {
+					return fail(err)
+				}
+				devs[app.DeveloperID] = true
+				for _, a := range devApps {
+					enqueue(a.AppID, item.Depth+1, "developer:"+app.DeveloperID)
+				}
+			}
+		}
+
+		state.Frontier = state.Frontier[1:]
+		state.Fetched++
+		sinceCheckpoint++
+		if sinceCheckpoint >= checkpointEvery {
+			sinceCheckpoint = 0
+			if err := save(); err != nil {
+				return result(), err
+			}
+		}
+	}
+	if err := save(); err != nil {
+		return result(), err
+	}
+	return result(), nil
+}
+
+func loadCrawlState(path string) (crawlState, bool, error) {
+	var state crawlState
+	if path == "" {
+		return state, false, nil
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, false, nil
+	}
+	if err != nil {
+		return state, false, err
+	}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return state, false, err
+	}
+	return state, true, nil
+}
+
+func saveCrawlState(path string, state crawlState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
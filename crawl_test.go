@@ -0,0 +1,125 @@
+package gplay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+type fakeCrawlSource struct {
+	apps     map[string]App
+	similars map[string][]string
+	devs     map[string][]string
+	calls    int
+	failAt   int
+}
+
+func (s *fakeCrawlSource) app(ctx context.Context, appID string) (App, error) {
+	s.calls++
+	if s.failAt > 0 && s.calls == s.failAt {
+		return App{}, errors.New("boom")
+	}
+	a, ok := s.apps[appID]
+	if !ok {
+		return App{}, &RequestError{StatusCode: 404}
+	}
+	return a, nil
+}
+
+func (s *fakeCrawlSource) similar(ctx context.Context, appID string) ([]App, error) {
+	ids, ok := s.similars[appID]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", appID, ErrSimilarNotFound)
+	}
+	return idsToApps(ids), nil
+}
+
+func (s *fakeCrawlSource) developer(ctx context.Context, devID string) ([]App, error) {
+	return idsToApps(s.devs[devID]), nil
+}
+
+func (s *fakeCrawlSource) search(ctx context.Context, term string) ([]App, error) {
+	return idsToApps([]string{"a"}), nil
+}
+
+func (s *fakeCrawlSource) list(ctx context.Context, collection Collection, category Category) ([]App, error) {
+	return nil, nil
+}
+
+func idsToApps(ids []string) []App {
+	out := make([]App, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, App{AppID: id})
+	}
+	return out
+}
+
+func newFakeCrawlSource() *fakeCrawlSource {
+	return &fakeCrawlSource{
+		apps: map[string]App{
+			"a": {AppID: "a", DeveloperID: "dev1"},
+			"b": {AppID: "b", DeveloperID: "dev1"},
+			"c": {AppID: "c", DeveloperID: "dev2"},
+			"d": {AppID: "d", DeveloperID: "dev2"},
+		},
+		similars: map[string][]string{"a": {"b", "c", "missing"}, "c": {"a", "d"}},
+		devs:     map[string][]string{"dev1": {"a", "b"}, "dev2": {"c", "d"}},
+	}
+}
+
+func TestCrawlDedupAndDepth(t *testing.T) {
+	var got []string
+	sink := AppSinkFunc(func(ctx context.Context, app App) error {
+		got = append(got, app.AppID)
+		return nil
+	})
+	res, err := crawl(context.Background(), newFakeCrawlSource(), CrawlOptions{SeedTerms: []string{"x"}, MaxDepth: 1, Sink: sink})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0] != "a" || res.Fetched != 3 || res.Failed != 1 || res.Discovered != 4 || res.Truncated {
+		t.Fatalf("unexpected crawl %v %+v", got, res)
+	}
+}
+
+func TestCrawlBudgetAndResume(t *testing.T) {
+	ctx := context.Background()
+	checkpoint := filepath.Join(t.TempDir(), "crawl.json")
+	var got []string
+	sink := AppSinkFunc(func(ctx context.Context, app App) error {
+		got = append(got, app.AppID)
+		return nil
+	})
+	opts := CrawlOptions{SeedApps: []string{"a"}, Budget: 2, Checkpoint: checkpoint, Sink: sink}
+	res, err := crawl(ctx, newFakeCrawlSource(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Fetched != 2 || !res.Truncated {
+		t.Fatalf("unexpected crawl %+v", res)
+	}
+
+	src := newFakeCrawlSource()
+	src.failAt = 2
+	opts.Budget = 10
+	if _, err := crawl(ctx, src, opts); err == nil {
+		t.Fatal("expected error")
+	}
+
+	res, err = crawl(ctx, newFakeCrawlSource(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Fetched != 4 || res.Failed != 1 || res.Remaining != 0 {
+		t.Fatalf("unexpected crawl %+v", res)
+	}
+	seen := map[string]int{}
+	for _, id := range got {
+		seen[id]++
+	}
+	if len(seen) != 4 || len(got) != 4 {
+		t.Fatalf("unexpected sink writes %v", got)
+	}
+}
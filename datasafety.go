@@ -3,13 +3,12 @@ package gplay
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"net/url"
 )
 
 func (c *Client) DataSafety(ctx context.Context, opts DataSafetyOptions) (DataSafetyResult, error) {
 	if opts.AppID == "" {
-		return DataSafetyResult{}, errors.New("appId missing")
+		return DataSafetyResult{}, optionError("appId missing")
 	}
 	lang := opts.Lang
 	if lang == "" {
@@ -0,0 +1,191 @@
+package gplay
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var monthNames = map[string][12][]string{
+	"en": {{"jan"}, {"feb"}, {"mar"}, {"apr"}, {"may"}, {"jun"}, {"jul"}, {"aug"}, {"sep"}, {"oct"}, {"nov"}, {"dec"}},
+	"es": {{"ene"}, {"feb"}, {"mar"}, {"abr"}, {"may"}, {"jun"}, {"jul"}, {"ago"}, {"sep"}, {"oct"}, {"nov"}, {"dic"}},
+	"pt": {{"jan"}, {"fev"}, {"mar"}, {"abr"}, {"mai"}, {"jun"}, {"jul"}, {"ago"}, {"set"}, {"out"}, {"nov"}, {"dez"}},
+	"fr": {{"janv"}, {"févr", "fevr"}, {"mars"}, {"avr"}, {"mai"}, {"juin"}, {"juil"}, {"août", "aout"}, {"sept"}, {"oct"}, {"nov"}, {"déc", "dec"}},
+	"de": {{"jan"}, {"feb"}, {"mär", "mar"}, {"apr"}, {"mai"}, {"jun"}, {"jul"}, {"aug"}, {"sep"}, {"okt"}, {"nov"}, {"dez"}},
+	"it": {{"gen"}, {"feb"}, {"mar"}, {"apr"}, {"mag"}, {"giu"}, {"lug"}, {"ago"}, {"set"}, {"ott"}, {"nov"}, {"dic"}},
+	"nl": {{"jan"}, {"feb"}, {"mrt", "maa"}, {"apr"}, {"mei"}, {"jun"}, {"jul"}, {"aug"}, {"sep"}, {"okt"}, {"nov"}, {"dec"}},
+	"sv": {{"jan"}, {"feb"}, {"mar"}, {"apr"}, {"maj"}, {"jun"}, {"jul"}, {"aug"}, {"sep"}, {"okt"}, {"nov"}, {"dec"}},
+	"da": {{"jan"}, {"feb"}, {"mar"}, {"apr"}, {"maj"}, {"jun"}, {"jul"}, {"aug"}, {"sep"}, {"okt"}, {"nov"}, {"dec"}},
+	"nb": {{"jan"}, {"feb"}, {"mar"}, {"apr"}, {"mai"}, {"jun"}, {"jul"}, {"aug"}, {"sep"}, {"okt"}, {"nov"}, {"des"}},
+	"pl": {{"sty"}, {"lut"}, {"mar"}, {"kwi"}, {"maj"}, {"cze"}, {"lip"}, {"sie"}, {"wrz"}, {"paź", "paz"}, {"lis"}, {"gru"}},
+	"tr": {{"oca"}, {"şub", "sub"}, {"mar"}, {"nis"}, {"may"}, {"haz"}, {"tem"}, {"ağu", "agu"}, {"eyl"}, {"eki"}, {"kas"}, {"ara"}},
+	"id": {{"jan"}, {"feb"}, {"mar"}, {"apr"}, {"mei"}, {"jun"}, {"jul"}, {"agu", "agt"}, {"sep"}, {"okt"}, {"nov"}, {"des"}},
+	"ru": {{"янв"}, {"фев"}, {"мар"}, {"апр"}, {"мая", "май"}, {"июн"}, {"июл"}, {"авг"}, {"сен"}, {"окт"}, {"ноя"}, {"дек"}},
+	"uk": {{"січ"}, {"лют"}, {"бер"}, {"кві"}, {"тра"}, {"чер"}, {"лип"}, {"сер"}, {"вер"}, {"жов"}, {"лис"}, {"гру"}},
+	"ar": {{"يناير"}, {"فبراير"}, {"مارس"}, {"أبريل", "ابريل"}, {"مايو"}, {"يونيو"}, {"يوليو"}, {"أغسطس", "اغسطس"}, {"سبتمبر"}, {"أكتوبر", "اكتوبر"}, {"نوفمبر"}, {"ديسمبر"}},
+	"hi": {{"जन"}, {"फ़र", "फ़र", "फर"}, {"मार्च"}, {"अप्रै"}, {"मई"}, {"जून"}, {"जुला"}, {"अग"}, {"सित"}, {"अक्तू", "अक्टू"}, {"नव"}, {"दिस"}},
+}
+
+var yearFirstLangs = map[string]bool{"ja": true, "zh": true, "ko": true, "hu": true, "lt": true}
+
+var monthFirstLangs = map[string]bool{"en": true}
+
+var SupportedDateLangs = supportedDateLangs()
+
+func supportedDateLangs() []string {
+	out := make([]string, 0, len(monthNames)+len(yearFirstLangs))
+	for l := range monthNames {
+		out = append(out, l)
+	}
+	for l := range yearFirstLangs {
+		if _, ok := monthNames[l]; !ok {
+			out = append(out, l)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func baseLang(lang string) string {
+	lang = strings.ToLower(lang)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+func normalizeDigits(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if unicode.IsDigit(r) && (r < '0' || r > '9') {
+			if d := digitValue(r); d >= 0 {
+				b.WriteByte(byte('0' + d))
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func digitValue(r rune) int {
+	for _, zero := range []rune{0x0660, 0x06F0, 0x0966, 0x09E6, 0x0E50, 0xFF10} {
+		if r >= zero && r <= zero+9 {
+			return int(r - zero)
+		}
+	}
+	return -1
+}
+
+type dateToken struct {
+	word string
+	num  int
+	len  int
+}
+
+func tokenizeDate(s string) []dateToken {
+	var out []dateToken
+	runes := []rune(strings.ToLower(normalizeDigits(s)))
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r >= '0' && r <= '9':
+			j := i
+			for j < len(runes) && runes[j] >= '0' && runes[j] <= '9' {
+				j++
+			}
+			n, _ := strconv.Atoi(string(runes[i:j]))
+			out = append(out, dateToken{num: n, len: j - i})
+			i = j
+		case unicode.IsLetter(r) || unicode.IsMark(r):
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsMark(runes[j])) {
+				j++
+			}
+			out = append(out, dateToken{word: string(runes[i:j])})
+			i = j
+		default:
+			i++
+		}
+	}
+	return out
+}
+
+func matchMonth(word string, langs []string) int {
+	best, bestLen := 0, 0
+	for _, l := range langs {
+		table, ok := monthNames[l]
+		if !ok {
+			continue
+		}
+		for m, forms := range table {
+			for _, f := range forms {
+				if strings.HasPrefix(word, f) && len(f) > bestLen {
+					best, bestLen = m+1, len(f)
+				}
+			}
+		}
+	}
+	return best
+}
+
+func ParseReleased(s string, lang string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, errors.New("empty date")
+	}
+	lang = baseLang(lang)
+	langs := []string{lang}
+	if _, ok := monthNames[lang]; !ok {
+		langs = SupportedDateLangs
+	}
+
+	tokens := tokenizeDate(s)
+	month := 0
+	var nums []dateToken
+	for _, t := range tokens {
+		if t.word != "" {
+			if month == 0 {
+				month = matchMonth(t.word, langs)
+			}
+			continue
+		}
+		nums = append(nums, t)
+	}
+
+	var year, day int
+	switch {
+	case month != 0 && len(nums) >= 2:
+		if nums[0].len == 4 {
+			year, day = nums[0].num, nums[1].num
+		} else {
+			day, year = nums[0].num, nums[1].num
+		}
+	case month == 0 && len(nums) >= 3:
+		switch {
+		case nums[0].len == 4 || yearFirstLangs[lang]:
+			year, month, day = nums[0].num, nums[1].num, nums[2].num
+		case monthFirstLangs[lang]:
+			month, day, year = nums[0].num, nums[1].num, nums[2].num
+		default:
+			day, month, year = nums[0].num, nums[1].num, nums[2].num
+		}
+	default:
+		return time.Time{}, errors.New("unrecognized date " + strconv.Quote(s))
+	}
+	if year < 100 {
+		year += 2000
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return time.Time{}, errors.New("unrecognized date " + strconv.Quote(s))
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if t.Day() != day {
+		return time.Time{}, errors.New("invalid date " + strconv.Quote(s))
+	}
+	return t, nil
+}
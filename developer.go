@@ -3,14 +3,13 @@ package gplay
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"net/url"
 	"strconv"
 )
 
 func (c *Client) Developer(ctx context.Context, opts DeveloperOptions) ([]App, error) {
 	if opts.DevID == "" {
-		return nil, errors.New("devId missing")
+		return nil, optionError("devId missing")
 	}
 	lang := opts.Lang
 	if lang == "" {
@@ -59,43 +58,21 @@ func (c *Client) Developer(ctx context.Context, opts DeveloperOptions) ([]App, e
 
 	appsRoot := []any{"ds:3", 0, 1, 0, 22, 0}
 	tokenPath := []any{"ds:3", 0, 1, 0, 22, 1, 3, 1}
-	m := map[string]fieldSpec{
-		"title":     {Path: []any{0, 3}},
-		"appId":     {Path: []any{0, 0, 0}},
-		"url":       {Path: []any{0, 10, 4, 2}, Fn: func(input any, _ parsedData) any { p, _ := asString(input); return resolveURL(BaseURL, p) }},
-		"icon":      {Path: []any{0, 1, 3, 2}},
-		"developer": {Path: []any{0, 14}},
-		"currency":  {Path: []any{0, 8, 1, 0, 1}},
-		"price":     {Path: []any{0, 8, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v / 1000000 }},
-		"free":      {Path: []any{0, 8, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v == 0 }},
-		"summary":   {Path: []any{0, 13, 1}},
-		"scoreText": {Path: []any{0, 4, 0}},
-		"score":     {Path: []any{0, 4, 1}},
-	}
+	m := clusterItemMappings(0)
 
 	if _, err := strconv.ParseInt(opts.DevID, 10, 64); err == nil {
 		appsRoot = []any{"ds:3", 0, 1, 0, 21, 0}
 		tokenPath = []any{"ds:3", 0, 1, 0, 21, 1, 3, 1}
-		m = map[string]fieldSpec{
-			"title":     {Path: []any{3}},
-			"appId":     {Path: []any{0, 0}},
-			"url":       {Path: []any{10, 4, 2}, Fn: func(input any, _ parsedData) any { p, _ := asString(input); return resolveURL(BaseURL, p) }},
-			"icon":      {Path: []any{1, 3, 2}},
-			"developer": {Path: []any{14}},
-			"currency":  {Path: []any{8, 1, 0, 1}},
-			"price":     {Path: []any{8, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v / 1000000 }},
-			"free":      {Path: []any{8, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v == 0 }},
-			"summary":   {Path: []any{13, 1}},
-			"scoreText": {Path: []any{4, 0}},
-			"score":     {Path: []any{4, 1}},
-		}
+		m = clusterItemMappings()
 	}
 
 	appsAny := pathGet(parsed, appsRoot)
 	appsArr, _ := appsAny.([]any)
 	appMaps := make([]map[string]any, 0, len(appsArr))
 	for _, it := range appsArr {
-		appMaps = append(appMaps, extractFields(parsedData{"root": it}, prefixMappings(m)))
+		fields := extractFields(parsedData{"root": it}, prefixMappings(m))
+		fillFromText(fields, lang)
+		appMaps = append(appMaps, fields)
 	}
 	token, _ := asString(pathGet(parsed, tokenPath))
 
@@ -104,6 +81,7 @@ func (c *Client) Developer(ctx context.Context, opts DeveloperOptions) ([]App, e
 	if err != nil {
 		return nil, err
 	}
+	assignPositions(more)
 
 	apps := make([]App, 0, len(more))
 	for _, mm := range more {
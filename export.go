@@ -0,0 +1,320 @@
+package gplay
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type flatKind int
+
+const (
+	flatScalar flatKind = iota
+	flatList
+	flatJSON
+)
+
+type flatField struct {
+	name  string
+	index []int
+	kind  flatKind
+}
+
+var (
+	flatFieldsMu    sync.Mutex
+	flatFieldsCache = map[reflect.Type][]flatField{}
+	timeType        = reflect.TypeOf(time.Time{})
+)
+
+func Columns(record any) []string {
+	fields, err := recordFields(reflect.TypeOf(record))
+	if err != nil {
+		return nil
+	}
+	out := make([]string, 0, len(fields))
+	for _, f := range fields {
+		out = append(out, f.name)
+	}
+	return out
+}
+
+func recordFields(t reflect.Type) ([]flatField, error) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot export %v records", t)
+	}
+	flatFieldsMu.Lock()
+	defer flatFieldsMu.Unlock()
+	if fields, ok := flatFieldsCache[t]; ok {
+		return fields, nil
+	}
+	fields := collectFlatFields(t, "", nil)
+	flatFieldsCache[t] = fields
+	return fields, nil
+}
+
+func collectFlatFields(t reflect.Type, prefix string, index []int) []flatField {
+	out := make([]flatField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		idx := append(append([]int{}, index...), i)
+		ft := sf.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			out = append(out, collectFlatFields(ft, prefix, idx)...)
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		name = prefix + name
+
+		switch {
+		case ft.Kind() == reflect.Struct && ft != timeType:
+			out = append(out, collectFlatFields(ft, name+".", idx)...)
+		case ft.Kind() == reflect.Slice && isScalarKind(ft.Elem().Kind()):
+			out = append(out, flatField{name: name, index: idx, kind: flatList})
+		case ft.Kind() == reflect.Slice, ft.Kind() == reflect.Map, ft.Kind() == reflect.Interface:
+			out = append(out, flatField{name: name, index: idx, kind: flatJSON})
+		default:
+			out = append(out, flatField{name: name, index: idx, kind: flatScalar})
+		}
+	}
+	return out
+}
+
+func isScalarKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func selectFields(fields []flatField, columns []string) ([]flatField, error) {
+	if len(columns) == 0 {
+		return fields, nil
+	}
+	byName := make(map[string]flatField, len(fields))
+	for _, f := range fields {
+		byName[f.name] = f
+	}
+	out := make([]flatField, 0, len(columns))
+	for _, c := range columns {
+		f, ok := byName[strings.TrimSpace(c)]
+		if !ok {
+			return nil, errors.New("unknown column " + c)
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+func fieldValue(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+func scalarText(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func (f flatField) text(rv reflect.Value) (string, error) {
+	v, ok := fieldValue(rv, f.index)
+	if !ok {
+		return "", nil
+	}
+	switch f.kind {
+	case flatList:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = scalarText(v.Index(i))
+		}
+		return strings.Join(parts, "|"), nil
+	case flatJSON:
+		if (v.Kind() == reflect.Map || v.Kind() == reflect.Slice) && v.IsNil() {
+			return "", nil
+		}
+		b, err := json.Marshal(v.Interface())
+		return string(b), err
+	}
+	return scalarText(v), nil
+}
+
+func recordValue(record any, t reflect.Type) (reflect.Value, error) {
+	rv := reflect.ValueOf(record)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Value{}, errors.New("nil record")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Type() != t {
+		return reflect.Value{}, fmt.Errorf("expected %v record, got %v", t, rv.Type())
+	}
+	return rv, nil
+}
+
+type CSVWriter struct {
+	cw          *csv.Writer
+	typ         reflect.Type
+	fields      []flatField
+	wroteHeader bool
+}
+
+func NewCSVWriter(w io.Writer, record any, columns ...string) (*CSVWriter, error) {
+	all, err := recordFields(reflect.TypeOf(record))
+	if err != nil {
+		return nil, err
+	}
+	fields, err := selectFields(all, columns)
+	if err != nil {
+		return nil, err
+	}
+	t := reflect.TypeOf(record)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return &CSVWriter{cw: csv.NewWriter(w), typ: t, fields: fields}, nil
+}
+
+func (w *CSVWriter) Columns() []string {
+	out := make([]string, 0, len(w.fields))
+	for _, f := range w.fields {
+		out = append(out, f.name)
+	}
+	return out
+}
+
+func (w *CSVWriter) WriteHeader() error {
+	if w.wroteHeader {
+		return nil
+	}
+	w.wroteHeader = true
+	return w.cw.Write(w.Columns())
+}
+
+func (w *CSVWriter) Write(record any) error {
+	if err := w.WriteHeader(); err != nil {
+		return err
+	}
+	rv, err := recordValue(record, w.typ)
+	if err != nil {
+		return err
+	}
+	row := make([]string, len(w.fields))
+	for i, f := range w.fields {
+		if row[i], err = f.text(rv); err != nil {
+			return err
+		}
+	}
+	return w.cw.Write(row)
+}
+
+func (w *CSVWriter) Flush() error {
+	w.cw.Flush()
+	return w.cw.Error()
+}
+
+type NDJSONWriter struct {
+	w      io.Writer
+	typ    reflect.Type
+	fields []flatField
+	buf    bytes.Buffer
+}
+
+func NewNDJSONWriter(w io.Writer, record any, columns ...string) (*NDJSONWriter, error) {
+	all, err := recordFields(reflect.TypeOf(record))
+	if err != nil {
+		return nil, err
+	}
+	fields, err := selectFields(all, columns)
+	if err != nil {
+		return nil, err
+	}
+	t := reflect.TypeOf(record)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return &NDJSONWriter{w: w, typ: t, fields: fields}, nil
+}
+
+func (w *NDJSONWriter) Write(record any) error {
+	rv, err := recordValue(record, w.typ)
+	if err != nil {
+		return err
+	}
+	w.buf.Reset()
+	w.buf.WriteByte('{')
+	for i, f := range w.fields {
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(f.name)
+		w.buf.Write(key)
+		w.buf.WriteByte(':')
+		var val any
+		if v, ok := fieldValue(rv, f.index); ok {
+			val = v.Interface()
+		}
+		b, err := json.Marshal(val)
+		if err != nil {
+			return err
+		}
+		w.buf.Write(b)
+	}
+	w.buf.WriteString("}\n")
+	_, err = w.w.Write(w.buf.Bytes())
+	return err
+}
@@ -0,0 +1,64 @@
+package gplay
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCSVWriterFlattensApps(t *testing.T) {
+	score := 4.5
+	genre := "Tools"
+	apps := []App{
+		{AppID: "com.a", Title: "A, the app", Score: &score, Genre: &genre, Screenshots: []string{"s1", "s2"}, Histogram: map[string]int64{"5": 10}, Position: &ResultPosition{Absolute: 2, Organic: 1}},
+		{AppID: "com.b"},
+	}
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, App{}, "appId", "title", "score", "genre", "screenshots", "histogram", "position.organic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range apps {
+		if err := w.Write(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	want := "appId,title,score,genre,screenshots,histogram,position.organic\n" +
+		"com.a,\"A, the app\",4.5,Tools,s1|s2,\"{\"\"5\"\":10}\",1\n" +
+		"com.b,,,,,,\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected csv:\n%s", buf.String())
+	}
+
+	if _, err := NewCSVWriter(&buf, App{}, "nope"); err == nil {
+		t.Fatalf("expected unknown column error")
+	}
+	if err := w.Write(Review{}); err == nil {
+		t.Fatalf("expected record type error")
+	}
+}
+
+func TestNDJSONWriterAndColumns(t *testing.T) {
+	cols := Columns(Review{})
+	if cols[0] != "id" || !strings.Contains(strings.Join(cols, ","), "criterias") {
+		t.Fatalf("unexpected review columns %v", cols)
+	}
+	if got := Columns(FeaturedResult{}); got[0] != "appId" || got[len(got)-1] != "badges" {
+		t.Fatalf("unexpected featured columns %v", got)
+	}
+
+	var buf bytes.Buffer
+	w, err := NewNDJSONWriter(&buf, PermissionItem{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(&PermissionItem{Permission: "read contacts", Type: "Contacts"}); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "{\"permission\":\"read contacts\",\"type\":\"Contacts\"}\n" {
+		t.Fatalf("unexpected ndjson %q", buf.String())
+	}
+}
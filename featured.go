@@ -0,0 +1,89 @@
+package gplay
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+)
+
+const (
+	BadgePlayPass        = "play_pass"
+	BadgePreregistration = "preregistration"
+	BadgeEarlyAccess     = "early_access"
+	BadgeContainsAds     = "contains_ads"
+	BadgeInAppPurchases  = "in_app_purchases"
+)
+
+type FeaturedResult struct {
+	App
+	Exact  bool     `json:"exact"`
+	Badges []string `json:"badges"`
+}
+
+var featuredRoot = []any{"ds:1", 0, 1, 0, 23}
+
+func extractFeatured(parsed parsedData, term, lang string) (*FeaturedResult, error) {
+	node := pathGet(parsed, featuredRoot)
+	if node == nil {
+		return nil, nil
+	}
+	detailsRoot := append(append([]any{}, featuredRoot...), 16, 2)
+	m := appDetailsMappings(detailsRoot, lang)
+	delete(m, "comments")
+	m["appId"] = fieldSpec{Path: append(append([]any{}, featuredRoot...), 16, 11, 0, 0)}
+	m["url"] = fieldSpec{Path: append(append([]any{}, featuredRoot...), 17, 0, 0, 4, 2), Fn: func(input any, _ parsedData) any {
+		s, _ := asString(input)
+		return resolveURL(BaseURL, s)
+	}}
+
+	fields := extractFields(parsed, m)
+	if id, _ := fields["appId"].(string); id == "" {
+		return nil, nil
+	}
+	fillFromText(fields, lang)
+
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	var app App
+	if err := json.Unmarshal(b, &app); err != nil {
+		return nil, err
+	}
+	return &FeaturedResult{App: app, Exact: isExactHit(app, term), Badges: appBadges(app)}, nil
+}
+
+func isExactHit(app App, term string) bool {
+	t := normalizeQuery(term)
+	if t == "" {
+		return false
+	}
+	return t == normalizeQuery(app.Title) || strings.EqualFold(strings.TrimSpace(term), app.AppID)
+}
+
+func normalizeQuery(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func appBadges(app App) []string {
+	is := func(b *bool) bool { return b != nil && *b }
+	out := make([]string, 0)
+	if is(app.IsAvailableInPlayPass) {
+		out = append(out, BadgePlayPass)
+	}
+	if is(app.Preregister) {
+		out = append(out, BadgePreregistration)
+	}
+	if is(app.EarlyAccessEnabled) {
+		out = append(out, BadgeEarlyAccess)
+	}
+	if is(app.AdSupported) {
+		out = append(out, BadgeContainsAds)
+	}
+	if is(app.OffersIAP) {
+		out = append(out, BadgeInAppPurchases)
+	}
+	return out
+}
@@ -0,0 +1,317 @@
+package gplay
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type EdgeKind string
+
+const (
+	EdgeSimilar   EdgeKind = "similar"
+	EdgeDeveloper EdgeKind = "developer"
+)
+
+type GraphNode struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Developer string   `json:"developer,omitempty"`
+	Score     *float64 `json:"score,omitempty"`
+	Installs  *string  `json:"installs,omitempty"`
+	Genre     *string  `json:"genre,omitempty"`
+	Depth     int      `json:"depth"`
+	Seed      bool     `json:"seed"`
+}
+
+type GraphEdge struct {
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Kind   EdgeKind `json:"kind"`
+}
+
+type AppGraph struct {
+	Nodes     []GraphNode `json:"nodes"`
+	Edges     []GraphEdge `json:"links"`
+	Truncated bool        `json:"truncated"`
+}
+
+func (c *Client) Graph(ctx context.Context, opts GraphOptions) (AppGraph, error) {
+	if opts.Lang == "" {
+		opts.Lang = "en"
+	}
+	if opts.Country == "" {
+		opts.Country = "us"
+	}
+	src := clientCrawlSource{c: c, opts: CrawlOptions{CallOptions: opts.CallOptions, Lang: opts.Lang, Country: opts.Country}}
+	return buildGraph(ctx, src, opts)
+}
+
+func buildGraph(ctx context.Context, src crawlSource, opts GraphOptions) (AppGraph, error) {
+	if len(opts.SeedApps) == 0 {
+		return AppGraph{}, optionError("seedApps missing")
+	}
+	depth := opts.Depth
+	if depth == 0 {
+		depth = 1
+	}
+	maxNodes := opts.MaxNodes
+	if maxNodes == 0 {
+		maxNodes = 200
+	}
+
+	var g AppGraph
+	index := map[string]int{}
+	edges := map[GraphEdge]bool{}
+	devs := map[string][]string{}
+	addNode := func(a App, d int) bool {
+		if a.AppID == "" {
+			return false
+		}
+		if _, ok := index[a.AppID]; ok {
+			return true
+		}
+		if len(g.Nodes) >= maxNodes {
+			g.Truncated = true
+			return false
+		}
+		index[a.AppID] = len(g.Nodes)
+		g.Nodes = append(g.Nodes, GraphNode{ID: a.AppID, Depth: d})
+		setNodeAttrs(&g.Nodes[len(g.Nodes)-1], a)
+		return true
+	}
+	addEdge := func(source, target string, kind EdgeKind) {
+		e := GraphEdge{Source: source, Target: target, Kind: kind}
+		if source == target || edges[e] {
+			return
+		}
+		edges[e] = true
+		g.Edges = append(g.Edges, e)
+	}
+
+	for _, id := range opts.SeedApps {
+		if addNode(App{AppID: id}, 0) {
+			g.Nodes[index[id]].Seed = true
+		}
+	}
+
+	for i := 0; i < len(g.Nodes); i++ {
+		if err := ctx.Err(); err != nil {
+			return g, err
+		}
+		node := g.Nodes[i]
+		expand := node.Depth < depth
+		if !expand && !opts.FullDetail {
+			continue
+		}
+		app, err := src.app(ctx, node.ID)
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return g, err
+		}
+		setNodeAttrs(&g.Nodes[i], app)
+		if !expand {
+			continue
+		}
+
+		if !opts.SkipSimilar {
+			similar, err := src.similar(ctx, node.ID)
+			if err != nil && !isNotFound(err) && !errors.Is(err, ErrSimilarNotFound) {
+				return g, err
+			}
+			for _, a := range similar {
+				if addNode(a, node.Depth+1) {
+					addEdge(node.ID, a.AppID, EdgeSimilar)
+				}
+			}
+		}
+		if !opts.SkipDeveloper && app.DeveloperID != "" {
+			ids, ok := devs[app.DeveloperID]
+			if !ok {
+				apps, err := src.developer(ctx, app.DeveloperID)
+				if err != nil && !isNotFound(err) {
+					return g, err
+				}
+				for _, a := range apps {
+					ids = append(ids, a.AppID)
+					addNode(a, node.Depth+1)
+				}
+				devs[app.DeveloperID] = ids
+			}
+			for _, id := range ids {
+				if _, ok := index[id]; ok {
+					addEdge(node.ID, id, EdgeDeveloper)
+				}
+			}
+		}
+	}
+	return g, nil
+}
+
+func setNodeAttrs(n *GraphNode, a App) {
+	if a.Title != "" {
+		n.Title = a.Title
+	}
+	if a.Developer != "" {
+		n.Developer = a.Developer
+	}
+	if a.Score != nil {
+		n.Score = a.Score
+	}
+	if a.Installs != nil {
+		n.Installs = a.Installs
+	}
+	if a.Genre != nil {
+		n.Genre = a.Genre
+	}
+}
+
+func (g AppGraph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("digraph apps {\n")
+	for _, n := range g.Nodes {
+		attrs := []string{"label=" + strconv.Quote(nodeLabel(n))}
+		for _, kv := range n.attrs() {
+			attrs = append(attrs, kv[0]+"="+strconv.Quote(kv[1]))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", strconv.Quote(n.ID), strings.Join(attrs, ", "))
+	}
+	for _, e := range g.Edges {
+		style := "solid"
+		if e.Kind == EdgeDeveloper {
+			style = "dashed"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [kind=%s, style=%s];\n", strconv.Quote(e.Source), strconv.Quote(e.Target), strconv.Quote(string(e.Kind)), style)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func nodeLabel(n GraphNode) string {
+	if n.Title != "" {
+		return n.Title
+	}
+	return n.ID
+}
+
+func (n GraphNode) attrs() [][2]string {
+	var out [][2]string
+	if n.Title != "" {
+		out = append(out, [2]string{"title", n.Title})
+	}
+	if n.Developer != "" {
+		out = append(out, [2]string{"developer", n.Developer})
+	}
+	if n.Score != nil {
+		out = append(out, [2]string{"score", strconv.FormatFloat(*n.Score, 'f', -1, 64)})
+	}
+	if n.Installs != nil {
+		out = append(out, [2]string{"installs", *n.Installs})
+	}
+	if n.Genre != nil {
+		out = append(out, [2]string{"genre", *n.Genre})
+	}
+	out = append(out, [2]string{"depth", strconv.Itoa(n.Depth)}, [2]string{"seed", strconv.FormatBool(n.Seed)})
+	return out
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLDoc struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   struct {
+		ID          string        `xml:"id,attr"`
+		EdgeDefault string        `xml:"edgedefault,attr"`
+		Nodes       []graphMLNode `xml:"node"`
+		Edges       []graphMLEdge `xml:"edge"`
+	} `xml:"graph"`
+}
+
+func (g AppGraph) WriteGraphML(w io.Writer) error {
+	doc := graphMLDoc{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "title", For: "node", AttrName: "title", AttrType: "string"},
+			{ID: "developer", For: "node", AttrName: "developer", AttrType: "string"},
+			{ID: "score", For: "node", AttrName: "score", AttrType: "double"},
+			{ID: "installs", For: "node", AttrName: "installs", AttrType: "string"},
+			{ID: "genre", For: "node", AttrName: "genre", AttrType: "string"},
+			{ID: "depth", For: "node", AttrName: "depth", AttrType: "int"},
+			{ID: "seed", For: "node", AttrName: "seed", AttrType: "boolean"},
+			{ID: "kind", For: "edge", AttrName: "kind", AttrType: "string"},
+		},
+	}
+	doc.Graph.ID = "apps"
+	doc.Graph.EdgeDefault = "directed"
+	for _, n := range g.Nodes {
+		node := graphMLNode{ID: n.ID}
+		for _, kv := range n.attrs() {
+			node.Data = append(node.Data, graphMLData{Key: kv[0], Value: kv[1]})
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, node)
+	}
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{Source: e.Source, Target: e.Target, Data: []graphMLData{{Key: "kind", Value: string(e.Kind)}}})
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func (g AppGraph) WriteNodeLink(w io.Writer) error {
+	nodes := g.Nodes
+	if nodes == nil {
+		nodes = []GraphNode{}
+	}
+	links := g.Edges
+	if links == nil {
+		links = []GraphEdge{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Directed   bool           `json:"directed"`
+		Multigraph bool           `json:"multigraph"`
+		Graph      map[string]any `json:"graph"`
+		Nodes      []GraphNode    `json:"nodes"`
+		Links      []GraphEdge    `json:"links"`
+	}{true, true, map[string]any{"truncated": g.Truncated}, nodes, links})
+}
@@ -0,0 +1,119 @@
+package gplay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestBuildGraph(t *testing.T) {
+	src := newFakeCrawlSource()
+	genre := "Tools"
+	a := src.apps["a"]
+	a.Title = "App A"
+	a.Genre = &genre
+	src.apps["a"] = a
+
+	g, err := buildGraph(context.Background(), src, GraphOptions{SeedApps: []string{"a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Nodes) != 4 || !g.Nodes[0].Seed || g.Nodes[0].Title != "App A" || *g.Nodes[0].Genre != "Tools" {
+		t.Fatalf("unexpected nodes %+v", g.Nodes)
+	}
+	want := []GraphEdge{
+		{Source: "a", Target: "b", Kind: EdgeSimilar},
+		{Source: "a", Target: "c", Kind: EdgeSimilar},
+		{Source: "a", Target: "missing", Kind: EdgeSimilar},
+		{Source: "a", Target: "b", Kind: EdgeDeveloper},
+	}
+	if len(g.Edges) != len(want) {
+		t.Fatalf("unexpected edges %+v", g.Edges)
+	}
+	for i := range want {
+		if g.Edges[i] != want[i] {
+			t.Fatalf("unexpected edge %d %+v", i, g.Edges[i])
+		}
+	}
+
+	g, err = buildGraph(context.Background(), newFakeCrawlSource(), GraphOptions{SeedApps: []string{"a"}, MaxNodes: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Nodes) != 2 || !g.Truncated {
+		t.Fatalf("unexpected graph %+v", g)
+	}
+}
+
+type installsCrawlSource struct {
+	*fakeCrawlSource
+}
+
+func (s installsCrawlSource) similar(ctx context.Context, appID string) ([]App, error) {
+	apps, err := s.fakeCrawlSource.similar(ctx, appID)
+	for i := range apps {
+		installs := "10K+"
+		apps[i].Installs = &installs
+	}
+	return apps, err
+}
+
+func TestBuildGraphInstallsFromLists(t *testing.T) {
+	g, err := buildGraph(context.Background(), installsCrawlSource{newFakeCrawlSource()}, GraphOptions{SeedApps: []string{"a"}, SkipDeveloper: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range g.Nodes[1:] {
+		if n.Installs == nil || *n.Installs != "10K+" {
+			t.Fatalf("expected installs from the similar list on %+v", n)
+		}
+	}
+}
+
+func TestGraphExports(t *testing.T) {
+	score := 4.5
+	g := AppGraph{
+		Nodes: []GraphNode{{ID: "a", Title: `Say "hi"`, Score: &score, Seed: true}, {ID: "b", Depth: 1}},
+		Edges: []GraphEdge{{Source: "a", Target: "b", Kind: EdgeSimilar}},
+	}
+
+	var dot bytes.Buffer
+	if err := g.WriteDOT(&dot); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(dot.String(), `"a" [label="Say \"hi\"", title="Say \"hi\"", score="4.5"`) ||
+		!strings.Contains(dot.String(), `"a" -> "b" [kind="similar"`) {
+		t.Fatalf("unexpected dot output:\n%s", dot.String())
+	}
+
+	var gml bytes.Buffer
+	if err := g.WriteGraphML(&gml); err != nil {
+		t.Fatal(err)
+	}
+	var doc graphMLDoc
+	if err := xml.Unmarshal(gml.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Graph.Nodes) != 2 || len(doc.Graph.Edges) != 1 || doc.Graph.Nodes[0].Data[1] != (graphMLData{Key: "score", Value: "4.5"}) {
+		t.Fatalf("unexpected graphml %+v", doc.Graph)
+	}
+
+	var nl bytes.Buffer
+	if err := g.WriteNodeLink(&nl); err != nil {
+		t.Fatal(err)
+	}
+	var parsed struct {
+		Directed bool             `json:"directed"`
+		Nodes    []map[string]any `json:"nodes"`
+		Links    []map[string]any `json:"links"`
+	}
+	if err := json.Unmarshal(nl.Bytes(), &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Directed || len(parsed.Nodes) != 2 || parsed.Nodes[0]["score"] != 4.5 || parsed.Links[0]["kind"] != "similar" {
+		t.Fatalf("unexpected node-link output %s", nl.String())
+	}
+}
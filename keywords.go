@@ -0,0 +1,132 @@
+package gplay
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+var defaultKeywordAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+type KeywordSuggestion struct {
+	Keyword  string  `json:"keyword"`
+	Prefix   string  `json:"prefix"`
+	Position int     `json:"position"`
+	Depth    int     `json:"depth"`
+	Parent   string  `json:"parent"`
+	Count    int     `json:"count"`
+	Weight   float64 `json:"weight"`
+}
+
+type KeywordNode struct {
+	KeywordSuggestion
+	Children []KeywordNode `json:"children"`
+}
+
+type KeywordExpansion struct {
+	Seed      string              `json:"seed"`
+	Tree      KeywordNode         `json:"tree"`
+	Keywords  []KeywordSuggestion `json:"keywords"`
+	Requests  int                 `json:"requests"`
+	Truncated bool                `json:"truncated"`
+}
+
+func (c *Client) ExpandKeywords(ctx context.Context, opts ExpandKeywordsOptions) (KeywordExpansion, error) {
+	seed := strings.TrimSpace(opts.Seed)
+	if seed == "" {
+		return KeywordExpansion{}, optionError("seed missing")
+	}
+	depth := opts.Depth
+	if depth == 0 {
+		depth = 2
+	}
+	budget := opts.Budget
+	if budget == 0 {
+		budget = 200
+	}
+	alphabet := opts.Alphabet
+	if alphabet == "" {
+		alphabet = defaultKeywordAlphabet
+	}
+
+	suggest := func(ctx context.Context, prefix string) ([]string, error) {
+		return c.Suggest(ctx, SuggestOptions{CallOptions: opts.CallOptions, Term: prefix, Lang: opts.Lang, Country: opts.Country})
+	}
+	return expandKeywords(ctx, seed, depth, budget, alphabet, suggest)
+}
+
+func expandKeywords(ctx context.Context, seed string, depth, budget int, alphabet string, suggest func(context.Context, string) ([]string, error)) (KeywordExpansion, error) {
+	exp := KeywordExpansion{Seed: seed}
+	seedKey := normalizeKeyword(seed)
+	entries := map[string]*KeywordSuggestion{}
+	children := map[string][]string{}
+	order := make([]string, 0)
+
+	type item struct {
+		keyword string
+		depth   int
+	}
+	queue := []item{{seed, 0}}
+
+expand:
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur.depth >= depth {
+			continue
+		}
+		curKey := normalizeKeyword(cur.keyword)
+		prefixes := []string{cur.keyword}
+		for _, r := range alphabet {
+			prefixes = append(prefixes, cur.keyword+" "+string(r))
+		}
+		for _, prefix := range prefixes {
+			if exp.Requests >= budget {
+				exp.Truncated = true
+				break expand
+			}
+			exp.Requests++
+			sugs, err := suggest(ctx, prefix)
+			if err != nil {
+				return KeywordExpansion{}, err
+			}
+			for i, s := range sugs {
+				key := normalizeKeyword(s)
+				if key == "" || key == seedKey {
+					continue
+				}
+				w := 1 / float64(i+1) / float64(cur.depth+1)
+				if e, ok := entries[key]; ok {
+					e.Count++
+					e.Weight += w
+					continue
+				}
+				entries[key] = &KeywordSuggestion{Keyword: s, Prefix: prefix, Position: i + 1, Depth: cur.depth + 1, Parent: cur.keyword, Count: 1, Weight: w}
+				order = append(order, key)
+				children[curKey] = append(children[curKey], key)
+				queue = append(queue, item{s, cur.depth + 1})
+			}
+		}
+	}
+
+	var build func(key string, s KeywordSuggestion) KeywordNode
+	build = func(key string, s KeywordSuggestion) KeywordNode {
+		node := KeywordNode{KeywordSuggestion: s, Children: make([]KeywordNode, 0, len(children[key]))}
+		for _, k := range children[key] {
+			node.Children = append(node.Children, build(k, *entries[k]))
+		}
+		return node
+	}
+	exp.Tree = build(seedKey, KeywordSuggestion{Keyword: seed})
+
+	exp.Keywords = make([]KeywordSuggestion, 0, len(order))
+	for _, k := range order {
+		exp.Keywords = append(exp.Keywords, *entries[k])
+	}
+	sort.SliceStable(exp.Keywords, func(i, j int) bool { return exp.Keywords[i].Weight > exp.Keywords[j].Weight })
+	return exp, nil
+}
+
+func normalizeKeyword(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
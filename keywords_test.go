@@ -0,0 +1,46 @@
+package gplay
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExpandKeywords(t *testing.T) {
+	responses := map[string][]string{
+		"vpn":       {"vpn free", "vpn proxy"},
+		"vpn a":     {"vpn app", "vpn free"},
+		"vpn free":  {"vpn free unlimited"},
+		"vpn proxy": {"VPN  Free"},
+	}
+	calls := 0
+	suggest := func(_ context.Context, prefix string) ([]string, error) {
+		calls++
+		return responses[prefix], nil
+	}
+
+	exp, err := expandKeywords(context.Background(), "vpn", 2, 100, "a", suggest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp.Requests != calls || calls != 8 || exp.Truncated {
+		t.Fatalf("unexpected requests %d/%d truncated=%v", exp.Requests, calls, exp.Truncated)
+	}
+	if len(exp.Keywords) != 4 || exp.Keywords[0].Keyword != "vpn free" || exp.Keywords[0].Count != 3 {
+		t.Fatalf("unexpected keywords %+v", exp.Keywords)
+	}
+	if len(exp.Tree.Children) != 3 || exp.Tree.Children[0].Keyword != "vpn free" || exp.Tree.Children[0].Children[0].Keyword != "vpn free unlimited" {
+		t.Fatalf("unexpected tree %+v", exp.Tree)
+	}
+	app := exp.Tree.Children[2]
+	if app.Keyword != "vpn app" || app.Prefix != "vpn a" || app.Position != 1 || app.Depth != 1 {
+		t.Fatalf("unexpected node %+v", app.KeywordSuggestion)
+	}
+
+	exp, err = expandKeywords(context.Background(), "vpn", 3, 3, "a", suggest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exp.Truncated || exp.Requests != 3 {
+		t.Fatalf("expected budget truncation, got %d requests", exp.Requests)
+	}
+}
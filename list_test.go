@@ -0,0 +1,62 @@
+package gplay
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestValidateListOptions(t *testing.T) {
+	cases := []struct {
+		collection Collection
+		category   Category
+		device     Device
+		ok         bool
+	}{
+		{CollectionTopFree, CategoryApplication, "", true},
+		{CollectionTrending, CategoryTools, DeviceTablet, true},
+		{CollectionTopPaidGames, CategoryGamePuzzle, "", true},
+		{CollectionTopPaidGames, CategoryTools, "", false},
+		{CollectionTopFree, CategoryWatchFace, DeviceWear, true},
+		{CollectionTopFree, CategoryWatchFace, "", true},
+		{CollectionTopFree, CategoryWatchFace, DevicePhone, false},
+		{CollectionTopFree, CategoryMapsAndNavigation, DeviceCar, true},
+		{CollectionTopFree, CategoryDating, DeviceCar, false},
+		{CollectionTopFree, CategoryApplication, Device("fridge"), false},
+	}
+	for _, tc := range cases {
+		err := validateListOptions(tc.collection, tc.category, tc.device)
+		if (err == nil) != tc.ok {
+			t.Errorf("%s/%s/%s: got err=%v, want ok=%t", tc.collection, tc.category, tc.device, err, tc.ok)
+		}
+	}
+}
+
+func TestListRequestBody(t *testing.T) {
+	cases := []struct {
+		device Device
+		tail   string
+	}{
+		{"", `[2,\"topselling_free\",\"GAME\"]]]"`},
+		{DevicePhone, `[2,\"topselling_free\",\"GAME\"]]]"`},
+		{DeviceTablet, `[2,\"topselling_free\",\"GAME\",null,[2]]]]"`},
+		{DeviceTV, `[2,\"topselling_free\",\"GAME\",null,[3]]]]"`},
+		{DeviceWear, `[2,\"topselling_free\",\"GAME\",null,[4]]]]"`},
+		{DeviceChromebook, `[2,\"topselling_free\",\"GAME\",null,[5]]]]"`},
+		{DeviceCar, `[2,\"topselling_free\",\"GAME\",null,[6]]]]"`},
+	}
+	for _, tc := range cases {
+		body := listRequestBody(100, "topselling_free", CategoryGame, tc.device)
+		form, err := url.ParseQuery(body)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.device, err)
+		}
+		req := form.Get("f.req")
+		if !strings.Contains(req, "[20,100]") {
+			t.Errorf("%s: num missing from %s", tc.device, req)
+		}
+		if !strings.Contains(req, tc.tail) {
+			t.Errorf("%s: expected %s in request, got %s", tc.device, tc.tail, req[len(req)-80:])
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package gplay
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sort"
+)
+
+type Locale struct {
+	Lang    string `json:"lang"`
+	Country string `json:"country"`
+}
+
+func (l Locale) String() string {
+	return l.Lang + "-" + l.Country
+}
+
+type LocaleFieldStatus string
+
+const (
+	LocaleFieldLocalized LocaleFieldStatus = "localized"
+	LocaleFieldFallback  LocaleFieldStatus = "fallback"
+	LocaleFieldBase      LocaleFieldStatus = "base"
+	LocaleFieldEmpty     LocaleFieldStatus = "empty"
+)
+
+var localizedAppFields = []string{"title", "summary", "description", "screenshots", "recentChanges"}
+
+type LocaleListing struct {
+	Locale Locale                       `json:"locale"`
+	Found  bool                         `json:"found"`
+	App    *App                         `json:"app,omitempty"`
+	Fields map[string]LocaleFieldStatus `json:"fields,omitempty"`
+}
+
+type AppLocalesResult struct {
+	AppID         string              `json:"appId"`
+	Base          Locale              `json:"base"`
+	EffectiveBase Locale              `json:"effectiveBase"`
+	BaseMissing   bool                `json:"baseMissing,omitempty"`
+	Listings      []LocaleListing     `json:"listings"`
+	Localized     map[string][]Locale `json:"localized"`
+	Fallback      map[string][]Locale `json:"fallback"`
+	Missing       []Locale            `json:"missing"`
+}
+
+func (c *Client) AppLocales(ctx context.Context, opts AppLocalesOptions) (AppLocalesResult, error) {
+	if opts.AppID == "" {
+		return AppLocalesResult{}, optionError("appId missing")
+	}
+	if len(opts.Locales) == 0 {
+		return AppLocalesResult{}, optionError("locales missing")
+	}
+	base := opts.Base
+	if base.Lang == "" {
+		base.Lang = "en"
+	}
+	if base.Country == "" {
+		base.Country = "us"
+	}
+	locales := append([]Locale{base}, opts.Locales...)
+	apps := make([]*App, len(locales))
+	err := fanOut(ctx, len(locales), opts.Concurrency, func(ctx context.Context, i int) error {
+		app, err := c.App(ctx, AppOptions{CallOptions: opts.CallOptions, AppID: opts.AppID, Lang: locales[i].Lang, Country: locales[i].Country})
+		if isNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		apps[i] = &app
+		return nil
+	})
+	if err != nil {
+		return AppLocalesResult{}, err
+	}
+	effective, baseApp := base, apps[0]
+	if baseApp == nil {
+		for i, app := range apps[1:] {
+			if app != nil {
+				effective, baseApp = opts.Locales[i], app
+				break
+			}
+		}
+	}
+	if baseApp == nil {
+		return AppLocalesResult{}, &RequestError{StatusCode: http.StatusNotFound, Message: "App not found (404)"}
+	}
+
+	res := classifyLocales(opts.AppID, effective, *baseApp, opts.Locales, apps[1:])
+	res.Base = base
+	res.BaseMissing = apps[0] == nil
+	return res, nil
+}
+
+func classifyLocales(appID string, base Locale, baseApp App, locales []Locale, apps []*App) AppLocalesResult {
+	res := AppLocalesResult{
+		AppID:         appID,
+		Base:          base,
+		EffectiveBase: base,
+		Localized:     map[string][]Locale{},
+		Fallback:      map[string][]Locale{},
+	}
+	baseFields := localizedFieldValues(baseApp)
+	for i, loc := range locales {
+		app := apps[i]
+		if app == nil {
+			res.Missing = append(res.Missing, loc)
+			res.Listings = append(res.Listings, LocaleListing{Locale: loc})
+			continue
+		}
+		fields := localizedFieldValues(*app)
+		statuses := make(map[string]LocaleFieldStatus, len(fields))
+		for _, name := range localizedAppFields {
+			st := compareLocalizedField(fields[name], baseFields[name], baseLang(loc.Lang) == baseLang(base.Lang))
+			statuses[name] = st
+			switch st {
+			case LocaleFieldLocalized:
+				res.Localized[name] = append(res.Localized[name], loc)
+			case LocaleFieldFallback:
+				res.Fallback[name] = append(res.Fallback[name], loc)
+			}
+		}
+		res.Listings = append(res.Listings, LocaleListing{Locale: loc, Found: true, App: app, Fields: statuses})
+	}
+	return res
+}
+
+func (r AppLocalesResult) LocalizedFields() []string {
+	out := make([]string, 0, len(r.Localized))
+	for name := range r.Localized {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func localizedFieldValues(a App) map[string]any {
+	str := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return map[string]any{
+		"title":         a.Title,
+		"summary":       a.Summary,
+		"description":   str(a.Description),
+		"screenshots":   a.Screenshots,
+		"recentChanges": str(a.RecentChanges),
+	}
+}
+
+func compareLocalizedField(v, base any, sameLang bool) LocaleFieldStatus {
+	if isEmptyValue(v) {
+		return LocaleFieldEmpty
+	}
+	if reflect.DeepEqual(v, base) {
+		if sameLang {
+			return LocaleFieldBase
+		}
+		return LocaleFieldFallback
+	}
+	return LocaleFieldLocalized
+}
+
+func isEmptyValue(v any) bool {
+	switch t := v.(type) {
+	case string:
+		return t == ""
+	case []string:
+		return len(t) == 0
+	default:
+		return v == nil
+	}
+}
+
+func isNotFound(err error) bool {
+	var re *RequestError
+	return errors.As(err, &re) && re.StatusCode == http.StatusNotFound
+}
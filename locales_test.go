@@ -0,0 +1,117 @@
+package gplay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestCompareLocalizedField(t *testing.T) {
+	tests := []struct {
+		name     string
+		v, base  any
+		sameLang bool
+		want     LocaleFieldStatus
+	}{
+		{"empty string", "", "Title", false, LocaleFieldEmpty},
+		{"empty slice", []string{}, []string{"a"}, false, LocaleFieldEmpty},
+		{"nil", nil, "x", false, LocaleFieldEmpty},
+		{"translated", "Titel", "Title", false, LocaleFieldLocalized},
+		{"copied from base", "Title", "Title", false, LocaleFieldFallback},
+		{"same language", "Title", "Title", true, LocaleFieldBase},
+		{"different screenshots", []string{"b"}, []string{"a"}, false, LocaleFieldLocalized},
+		{"same screenshots", []string{"a"}, []string{"a"}, false, LocaleFieldFallback},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareLocalizedField(tt.v, tt.base, tt.sameLang); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalizedFieldValues(t *testing.T) {
+	desc := "Description"
+	got := localizedFieldValues(App{Title: "Title", Summary: "Summary", Description: &desc, Screenshots: []string{"s1"}})
+	want := map[string]any{
+		"title":         "Title",
+		"summary":       "Summary",
+		"description":   "Description",
+		"screenshots":   []string{"s1"},
+		"recentChanges": "",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestClassifyLocales(t *testing.T) {
+	base := Locale{Lang: "en", Country: "us"}
+	de := Locale{Lang: "de", Country: "de"}
+	gb := Locale{Lang: "en-GB", Country: "gb"}
+	fr := Locale{Lang: "fr", Country: "fr"}
+	baseApp := App{Title: "Title", Summary: "Summary"}
+	deApp := App{Title: "Titel", Summary: "Summary"}
+	gbApp := App{Title: "Title", Summary: "Summary"}
+
+	res := classifyLocales("com.a", base, baseApp, []Locale{de, gb, fr}, []*App{&deApp, &gbApp, nil})
+	if !reflect.DeepEqual(res.Localized["title"], []Locale{de}) {
+		t.Fatalf("unexpected localized titles %v", res.Localized["title"])
+	}
+	if !reflect.DeepEqual(res.Fallback["summary"], []Locale{de}) {
+		t.Fatalf("unexpected fallback summaries %v", res.Fallback["summary"])
+	}
+	if !reflect.DeepEqual(res.Missing, []Locale{fr}) {
+		t.Fatalf("unexpected missing %v", res.Missing)
+	}
+	if st := res.Listings[1].Fields["title"]; st != LocaleFieldBase {
+		t.Fatalf("unexpected en-GB title status %q", st)
+	}
+	if st := res.Listings[0].Fields["description"]; st != LocaleFieldEmpty {
+		t.Fatalf("unexpected description status %q", st)
+	}
+	if res.Listings[2].Found {
+		t.Fatal("expected fr listing to be missing")
+	}
+}
+
+func TestAppLocalesMissingBase(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang := r.URL.Query().Get("hl")
+		if lang == "en" || lang == "fr" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		details := make([]any, 141)
+		details[0] = []any{"Title " + lang}
+		data, _ := json.Marshal([]any{nil, []any{nil, nil, details}})
+		fmt.Fprintf(w, "<script>AF_initDataCallback({key: 'ds:5', hash: '1', data:%s, sideChannel: {}});</script>", data)
+	}))
+	defer srv.Close()
+	c, err := NewClient(ClientOptions{BaseURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fr := Locale{Lang: "fr", Country: "fr"}
+	de := Locale{Lang: "de", Country: "de"}
+	res, err := c.AppLocales(context.Background(), AppLocalesOptions{CallOptions: CallOptions{Throttle: 100}, AppID: "com.a", Locales: []Locale{fr, de}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.BaseMissing || res.Base != (Locale{Lang: "en", Country: "us"}) || res.EffectiveBase != de {
+		t.Fatalf("unexpected base %+v effective %+v missing %t", res.Base, res.EffectiveBase, res.BaseMissing)
+	}
+	if !reflect.DeepEqual(res.Missing, []Locale{fr}) {
+		t.Fatalf("unexpected missing %v", res.Missing)
+	}
+
+	if _, err := c.AppLocales(context.Background(), AppLocalesOptions{CallOptions: CallOptions{Throttle: 100}, AppID: "com.a", Locales: []Locale{fr}}); !isNotFound(err) {
+		t.Fatalf("expected not found without any listing, got %v", err)
+	}
+}
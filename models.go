@@ -19,10 +19,11 @@ type App struct {
 	Score     *float64 `json:"score"`
 	ScoreText *string  `json:"scoreText"`
 
-	PriceText *string  `json:"priceText"`
-	Free      *bool    `json:"free"`
-	Currency  *string  `json:"currency"`
-	Price     *float64 `json:"price"`
+	PriceText   *string  `json:"priceText"`
+	Free        *bool    `json:"free"`
+	Currency    *string  `json:"currency"`
+	Price       *float64 `json:"price"`
+	PriceMicros *int64   `json:"priceMicros"`
 
 	Description     *string `json:"description"`
 	DescriptionHTML *string `json:"descriptionHTML"`
@@ -36,8 +37,9 @@ type App struct {
 
 	Histogram map[string]int64 `json:"histogram"`
 
-	OriginalPrice   *float64 `json:"originalPrice"`
-	DiscountEndDate *string  `json:"discountEndDate"`
+	OriginalPrice       *float64 `json:"originalPrice"`
+	OriginalPriceMicros *int64   `json:"originalPriceMicros"`
+	DiscountEndDate     *string  `json:"discountEndDate"`
 
 	Available *bool   `json:"available"`
 	OffersIAP *bool   `json:"offersIAP"`
@@ -83,6 +85,14 @@ type App struct {
 	Preregister           *bool `json:"preregister"`
 	EarlyAccessEnabled    *bool `json:"earlyAccessEnabled"`
 	IsAvailableInPlayPass *bool `json:"isAvailableInPlayPass"`
+
+	Sponsored bool            `json:"sponsored"`
+	Position  *ResultPosition `json:"position,omitempty"`
+}
+
+type ResultPosition struct {
+	Absolute int `json:"absolute"`
+	Organic  int `json:"organic"`
 }
 
 type Review struct {
@@ -0,0 +1,231 @@
+package gplay
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const microsPerUnit = 1000000
+
+type Money struct {
+	Micros   int64  `json:"micros"`
+	Currency string `json:"currency"`
+}
+
+var currencyMinorUnits = map[string]int{
+	"BHD": 3, "BIF": 0, "CLF": 4, "CLP": 0, "DJF": 0, "GNF": 0, "IQD": 3, "ISK": 0,
+	"JOD": 3, "JPY": 0, "KMF": 0, "KRW": 0, "KWD": 3, "LYD": 3, "OMR": 3, "PYG": 0,
+	"RWF": 0, "TND": 3, "UGX": 0, "UYI": 0, "UYW": 4, "VND": 0, "VUV": 0, "XAF": 0,
+	"XOF": 0, "XPF": 0,
+}
+
+var commaDecimalLangs = map[string]bool{
+	"de": true, "fr": true, "es": true, "it": true, "pt": true, "ru": true, "nl": true,
+	"pl": true, "tr": true, "id": true, "uk": true, "sv": true, "da": true, "nb": true,
+	"no": true, "fi": true, "cs": true, "sk": true, "hu": true, "ro": true, "bg": true,
+	"el": true, "hr": true, "sl": true, "sr": true, "lt": true, "lv": true, "et": true,
+	"vi": true, "az": true, "kk": true, "be": true, "ca": true,
+}
+
+func CurrencyDigits(code string) int {
+	if d, ok := currencyMinorUnits[strings.ToUpper(code)]; ok {
+		return d
+	}
+	return 2
+}
+
+func (m Money) Digits() int {
+	return CurrencyDigits(m.Currency)
+}
+
+func (m Money) MinorUnits() int64 {
+	div := int64(microsPerUnit)
+	for i := 0; i < m.Digits(); i++ {
+		div /= 10
+	}
+	return m.Micros / div
+}
+
+func (m Money) Float() float64 {
+	return float64(m.Micros) / microsPerUnit
+}
+
+func (m Money) IsZero() bool {
+	return m.Micros == 0
+}
+
+func (m Money) Amount() string {
+	digits := m.Digits()
+	minor := m.MinorUnits()
+	neg := minor < 0
+	if neg {
+		minor = -minor
+	}
+	s := strconv.FormatInt(minor, 10)
+	if digits > 0 {
+		for len(s) <= digits {
+			s = "0" + s
+		}
+		s = s[:len(s)-digits] + "." + s[len(s)-digits:]
+	}
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Amount()
+	}
+	return m.Amount() + " " + m.Currency
+}
+
+func (a App) PriceMoney() (Money, bool) {
+	if a.PriceMicros == nil {
+		return Money{}, false
+	}
+	cur := ""
+	if a.Currency != nil {
+		cur = *a.Currency
+	}
+	return Money{Micros: *a.PriceMicros, Currency: cur}, true
+}
+
+func (a App) OriginalPriceMoney() (Money, bool) {
+	if a.OriginalPriceMicros == nil {
+		return Money{}, false
+	}
+	cur := ""
+	if a.Currency != nil {
+		cur = *a.Currency
+	}
+	return Money{Micros: *a.OriginalPriceMicros, Currency: cur}, true
+}
+
+func ParsePriceText(text, currency, lang string) (Money, error) {
+	num := ""
+	started := false
+scan:
+	for _, r := range normalizeDigits(text) {
+		switch {
+		case r >= '0' && r <= '9':
+			num += string(r)
+			started = true
+		case r == '.' || r == ',' || r == '٫' || r == '٬':
+			if started {
+				num += string(r)
+			}
+		case r == '\'' || r == '’' || unicode.IsSpace(r):
+			if started {
+				num += " "
+			}
+		default:
+			if started {
+				break scan
+			}
+		}
+	}
+	num = strings.TrimRight(num, ".,٫٬ ")
+	if num == "" {
+		return Money{}, errors.New("no price in " + strconv.Quote(text))
+	}
+
+	intPart, fracPart := splitDecimal(num, CurrencyDigits(currency), baseLang(lang))
+	whole, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return Money{}, err
+	}
+	if len(fracPart) > 6 {
+		fracPart = fracPart[:6]
+	}
+	for len(fracPart) < 6 {
+		fracPart += "0"
+	}
+	frac, err := strconv.ParseInt(fracPart, 10, 64)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Micros: whole*microsPerUnit + frac, Currency: strings.ToUpper(currency)}, nil
+}
+
+func splitDecimal(num string, digits int, lang string) (string, string) {
+	if i := strings.IndexRune(num, '٫'); i >= 0 {
+		return stripGroups(num[:i]), stripGroups(num[i+len("٫"):])
+	}
+	lastDot := strings.LastIndexByte(num, '.')
+	lastComma := strings.LastIndexByte(num, ',')
+	sep := -1
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		sep = lastDot
+		if lastComma > lastDot {
+			sep = lastComma
+		}
+	case lastDot >= 0 || lastComma >= 0:
+		sep = lastDot
+		ch := byte('.')
+		if lastComma >= 0 {
+			sep, ch = lastComma, ','
+		}
+		tail := len(stripGroups(num[sep+1:]))
+		switch {
+		case strings.Count(num, string(ch)) > 1:
+			sep = -1
+		case tail != 3:
+		case digits == 3:
+		case lang != "" && (ch == ',') == commaDecimalLangs[lang]:
+		default:
+			sep = -1
+		}
+	}
+	if sep < 0 {
+		return stripGroups(num), ""
+	}
+	return stripGroups(num[:sep]), stripGroups(num[sep+1:])
+}
+
+func stripGroups(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 {
+		return "0"
+	}
+	return b.String()
+}
+
+func priceFromMicros(input any, _ parsedData) any {
+	v, _ := asInt64(input)
+	return float64(v) / microsPerUnit
+}
+
+func priceMicros(input any, _ parsedData) any {
+	v, _ := asInt64(input)
+	return v
+}
+
+func fillPriceFromText(fields map[string]any, lang string) {
+	if v, _ := fields["priceMicros"].(int64); v != 0 {
+		return
+	}
+	if free, _ := fields["free"].(bool); free {
+		return
+	}
+	text, _ := fields["priceText"].(string)
+	if text == "" {
+		return
+	}
+	currency, _ := fields["currency"].(string)
+	m, err := ParsePriceText(text, currency, lang)
+	if err != nil || m.Micros == 0 {
+		return
+	}
+	fields["priceMicros"] = m.Micros
+	fields["price"] = m.Float()
+}
@@ -0,0 +1,59 @@
+package gplay
+
+import "testing"
+
+func TestParsePriceText(t *testing.T) {
+	cases := []struct {
+		text     string
+		currency string
+		lang     string
+		want     int64
+	}{
+		{"$1.99", "USD", "en", 1990000},
+		{"1.234,56 €", "EUR", "de", 1234560000},
+		{"1 234,56 €", "EUR", "fr", 1234560000},
+		{"1,234.56 US$", "USD", "en", 1234560000},
+		{"1.234 €", "EUR", "de", 1234000000},
+		{"¥120", "JPY", "ja", 120000000},
+		{"₩1,200", "KRW", "ko", 1200000000},
+		{"KWD 1.250", "KWD", "ar", 1250000},
+		{"CHF 1'234.50", "CHF", "de", 1234500000},
+		{"₹1,23,456.00", "INR", "hi", 123456000000},
+		{"٣٫٤٩ US$", "USD", "ar", 3490000},
+		{"R$ 5,99", "BRL", "pt-BR", 5990000},
+		{"0,99 €", "EUR", "", 990000},
+	}
+	for _, tc := range cases {
+		got, err := ParsePriceText(tc.text, tc.currency, tc.lang)
+		if err != nil {
+			t.Errorf("%q: %v", tc.text, err)
+			continue
+		}
+		if got.Micros != tc.want {
+			t.Errorf("%q: got %d micros, want %d", tc.text, got.Micros, tc.want)
+		}
+	}
+	if _, err := ParsePriceText("Free", "", "en"); err == nil {
+		t.Fatalf("expected error for Free")
+	}
+}
+
+func TestMoneyFormatting(t *testing.T) {
+	cases := []struct {
+		m    Money
+		want string
+	}{
+		{Money{Micros: 1990000, Currency: "USD"}, "1.99 USD"},
+		{Money{Micros: 120000000, Currency: "JPY"}, "120 JPY"},
+		{Money{Micros: 1250000, Currency: "KWD"}, "1.250 KWD"},
+		{Money{Micros: 50000, Currency: "EUR"}, "0.05 EUR"},
+	}
+	for _, tc := range cases {
+		if got := tc.m.String(); got != tc.want {
+			t.Errorf("got %q, want %q", got, tc.want)
+		}
+	}
+	if got := (Money{Micros: 1990000, Currency: "USD"}).MinorUnits(); got != 199 {
+		t.Fatalf("expected 199 cents, got %d", got)
+	}
+}
@@ -0,0 +1,65 @@
+package gplay
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+)
+
+func appendNDJSON[T any](path string, records []T) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func scanNDJSON[T any](path string, match func(T) bool) ([]T, error) {
+	out := make([]T, 0)
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 16<<20)
+	for sc.Scan() {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		var r T
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			return nil, err
+		}
+		if match(r) {
+			out = append(out, r)
+		}
+	}
+	return out, sc.Err()
+}
+
+func filterRecords[T any](records []T, match func(T) bool) []T {
+	out := make([]T, 0)
+	for _, r := range records {
+		if match(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
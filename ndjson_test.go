@@ -0,0 +1,42 @@
+package gplay
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNDJSONAppendAndScan(t *testing.T) {
+	type record struct {
+		ID    string `json:"id"`
+		Count int    `json:"count"`
+	}
+	path := filepath.Join(t.TempDir(), "records.ndjson")
+	all := func(record) bool { return true }
+
+	got, err := scanNDJSON(path, all)
+	if err != nil || len(got) != 0 {
+		t.Fatalf("expected empty result for missing file, got %v %v", got, err)
+	}
+	if err := appendNDJSON(path, []record{{"a", 1}, {"b", 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := appendNDJSON(path, []record{{"c", 3}}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = scanNDJSON(path, func(r record) bool { return r.Count >= 2 })
+	if err != nil || len(got) != 2 || got[0].ID != "b" || got[1].ID != "c" {
+		t.Fatalf("unexpected records %v %v", got, err)
+	}
+
+	if err := os.WriteFile(path, []byte("{\"id\":\"a\"}\n\nnot json\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := scanNDJSON(path, all); err == nil {
+		t.Fatal("expected decode error")
+	}
+
+	if got := filterRecords([]record{{"a", 1}, {"b", 2}}, func(r record) bool { return r.ID == "b" }); len(got) != 1 || got[0].Count != 2 {
+		t.Fatalf("unexpected filtered records %v", got)
+	}
+}
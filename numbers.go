@@ -0,0 +1,175 @@
+package gplay
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type numberSuffix struct {
+	word string
+	mult float64
+}
+
+var numberSuffixes = map[string][]numberSuffix{
+	"en": {{"k", 1e3}, {"m", 1e6}, {"b", 1e9}},
+	"de": {{"tsd", 1e3}, {"mio", 1e6}, {"mrd", 1e9}},
+	"fr": {{"k", 1e3}, {"md", 1e9}, {"m", 1e6}},
+	"es": {{"mil", 1e3}, {"k", 1e3}, {"mm", 1e9}, {"m", 1e6}},
+	"pt": {{"mil", 1e3}, {"mi", 1e6}, {"bi", 1e9}},
+	"it": {{"mln", 1e6}, {"mld", 1e9}, {"mrd", 1e9}, {"k", 1e3}},
+	"nl": {{"k", 1e3}, {"mln", 1e6}, {"mld", 1e9}, {"mrd", 1e9}},
+	"pl": {{"tys", 1e3}, {"mln", 1e6}, {"mld", 1e9}},
+	"tr": {{"b", 1e3}, {"mn", 1e6}, {"mr", 1e9}, {"mlr", 1e9}},
+	"id": {{"rb", 1e3}, {"jt", 1e6}, {"m", 1e9}},
+	"ru": {{"тыс", 1e3}, {"млн", 1e6}, {"млрд", 1e9}},
+	"uk": {{"тис", 1e3}, {"млн", 1e6}, {"млрд", 1e9}},
+	"ja": {{"千", 1e3}, {"万", 1e4}, {"億", 1e8}},
+	"zh": {{"千", 1e3}, {"万", 1e4}, {"萬", 1e4}, {"亿", 1e8}, {"億", 1e8}},
+	"ko": {{"천", 1e3}, {"만", 1e4}, {"억", 1e8}},
+	"ar": {{"ألف", 1e3}, {"آلاف", 1e3}, {"مليون", 1e6}, {"ملايين", 1e6}, {"مليار", 1e9}},
+	"hi": {{"हज़ार", 1e3}, {"हज़ार", 1e3}, {"हजार", 1e3}, {"लाख", 1e5}, {"क", 1e7}, {"करोड़", 1e7}, {"करोड़", 1e7}, {"अरब", 1e9}},
+	"vi": {{"n", 1e3}, {"tr", 1e6}, {"t", 1e9}},
+}
+
+func ParseLocalizedNumber(s string, lang string) (float64, error) {
+	lang = baseLang(lang)
+	text := strings.ToLower(normalizeDigits(strings.TrimSpace(s)))
+	if text == "" {
+		return 0, errors.New("empty number")
+	}
+
+	num := ""
+	rest := ""
+	started := false
+	for i, r := range text {
+		switch {
+		case r >= '0' && r <= '9':
+			num += string(r)
+			started = true
+			continue
+		case started && (r == '.' || r == ',' || r == '٫' || r == '٬' || r == '\'' || r == '’' || unicode.IsSpace(r)):
+			num += string(r)
+			continue
+		}
+		if started {
+			rest = text[i:]
+			break
+		}
+	}
+	num = strings.TrimRightFunc(num, func(r rune) bool { return r < '0' || r > '9' })
+	if num == "" {
+		return 0, errors.New("no number in " + strconv.Quote(s))
+	}
+
+	intPart, fracPart := splitDecimal(num, 2, lang)
+	v, err := strconv.ParseFloat(intPart+"."+fracPart, 64)
+	if err != nil {
+		return 0, err
+	}
+	return v * suffixMultiplier(rest, lang), nil
+}
+
+func suffixMultiplier(rest string, lang string) float64 {
+	table, ok := numberSuffixes[lang]
+	if !ok {
+		table = numberSuffixes["en"]
+	}
+	trim := func(r rune) bool { return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) }
+	word := strings.TrimFunc(rest, trim)
+	mult := float64(1)
+	for word != "" {
+		best, bestLen := float64(0), 0
+		for _, suf := range table {
+			if strings.HasPrefix(word, suf.word) && len(suf.word) > bestLen {
+				best, bestLen = suf.mult, len(suf.word)
+			}
+		}
+		if bestLen == 0 {
+			break
+		}
+		mult *= best
+		word = strings.TrimLeftFunc(word[bestLen:], trim)
+	}
+	return mult
+}
+
+func ParseInstalls(s string, lang string) (int64, bool) {
+	v, err := ParseLocalizedNumber(strings.Trim(strings.TrimSpace(s), "+"), lang)
+	if err != nil {
+		return 0, false
+	}
+	return int64(v + 0.5), true
+}
+
+func countOrText(textPath []any, lang string) func(any, parsedData) any {
+	return func(input any, data parsedData) any {
+		if v, ok := asInt64(input); ok {
+			return v
+		}
+		text, _ := asString(pathGet(data, textPath))
+		if text == "" {
+			return int64(0)
+		}
+		v, err := ParseLocalizedNumber(text, lang)
+		if err != nil {
+			return int64(0)
+		}
+		return int64(v + 0.5)
+	}
+}
+
+func installsText(input any, _ parsedData) any {
+	s, _ := asString(input)
+	if !strings.HasSuffix(strings.TrimSpace(s), "+") || !strings.ContainsAny(s, "0123456789") {
+		return nil
+	}
+	return s
+}
+
+func installsBucketMax(minInstalls int64) int64 {
+	if minInstalls <= 0 {
+		return 0
+	}
+	p := int64(1)
+	for p*10 <= minInstalls {
+		p *= 10
+	}
+	switch minInstalls {
+	case p:
+		return 5*p - 1
+	case 5 * p:
+		return 10*p - 1
+	}
+	return 0
+}
+
+func fillNumbersFromText(fields map[string]any, lang string) {
+	minInstalls, _ := asInt64(fields["minInstalls"])
+	if minInstalls == 0 {
+		if text, _ := fields["installs"].(string); text != "" {
+			if n, ok := ParseInstalls(text, lang); ok {
+				fields["minInstalls"] = n
+				minInstalls = n
+			}
+		}
+	}
+	if v, _ := asInt64(fields["maxInstalls"]); v == 0 {
+		if n := installsBucketMax(minInstalls); n > 0 {
+			fields["maxInstalls"] = n
+		}
+	}
+	if fields["score"] == nil {
+		if text, _ := fields["scoreText"].(string); text != "" {
+			if v, err := ParseLocalizedNumber(text, lang); err == nil {
+				fields["score"] = v
+			}
+		}
+	}
+}
+
+func fillFromText(fields map[string]any, lang string) {
+	fillPriceFromText(fields, lang)
+	fillNumbersFromText(fields, lang)
+}
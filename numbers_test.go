@@ -0,0 +1,123 @@
+package gplay
+
+import "testing"
+
+func TestParseInstalls(t *testing.T) {
+	cases := []struct {
+		lang string
+		in   string
+		want int64
+	}{
+		{"en", "1,000,000+", 1000000},
+		{"en", "10M+", 10000000},
+		{"en", "5B+", 5000000000},
+		{"en", "100K+", 100000},
+		{"de", "1 Mio.+", 1000000},
+		{"de", "10.000+", 10000},
+		{"de", "5 Mrd.+", 5000000000},
+		{"fr", "1 M+", 1000000},
+		{"fr", "1 Md+", 1000000000},
+		{"fr", "10 000+", 10000},
+		{"es", "100 mil+", 100000},
+		{"pt-BR", "10 mi+", 10000000},
+		{"pt-BR", "1 bi+", 1000000000},
+		{"ru", "10 млн+", 10000000},
+		{"ru", "500 тыс.+", 500000},
+		{"ja", "1億+", 100000000},
+		{"ja", "1,000万+", 10000000},
+		{"ko", "5천만+", 50000000},
+		{"ko", "1억+", 100000000},
+		{"zh-TW", "1萬+", 10000},
+		{"ar", "+١٠ مليون", 10000000},
+		{"hi", "1 क॰+", 10000000},
+		{"hi", "10 लाख+", 1000000},
+		{"id", "10 jt+", 10000000},
+		{"tr", "100 B+", 100000},
+	}
+	for _, tc := range cases {
+		got, ok := ParseInstalls(tc.in, tc.lang)
+		if !ok || got != tc.want {
+			t.Errorf("%s %q: got %d,%t want %d", tc.lang, tc.in, got, ok, tc.want)
+		}
+	}
+}
+
+func TestParseLocalizedNumber(t *testing.T) {
+	cases := []struct {
+		lang string
+		in   string
+		want float64
+	}{
+		{"en", "4.5", 4.5},
+		{"de", "4,5", 4.5},
+		{"fr", "4,3", 4.3},
+		{"ru", "3,9", 3.9},
+		{"en", "1,234,567 reviews", 1234567},
+		{"de", "1.234.567 Rezensionen", 1234567},
+		{"en", "1.2M reviews", 1200000},
+		{"de", "1,5 Mio. Rezensionen", 1500000},
+	}
+	for _, tc := range cases {
+		got, err := ParseLocalizedNumber(tc.in, tc.lang)
+		if err != nil || got != tc.want {
+			t.Errorf("%s %q: got %v,%v want %v", tc.lang, tc.in, got, err, tc.want)
+		}
+	}
+}
+
+func TestFillNumbersFromText(t *testing.T) {
+	fields := map[string]any{"installs": "1 Mio.+", "minInstalls": float64(1234567)}
+	fillNumbersFromText(fields, "de")
+	if fields["minInstalls"] != float64(1234567) {
+		t.Fatalf("numeric minInstalls overwritten: %v", fields["minInstalls"])
+	}
+
+	fields = map[string]any{"installs": "1 Mio.+", "scoreText": "4,3"}
+	fillNumbersFromText(fields, "de")
+	if fields["minInstalls"] != int64(1000000) || fields["score"] != 4.3 {
+		t.Fatalf("unexpected fields %v", fields)
+	}
+}
+
+func TestInstallsBucketMax(t *testing.T) {
+	cases := map[int64]int64{1: 4, 5: 9, 100: 499, 500: 999, 1000000: 4999999, 5000000000: 9999999999, 1234: 0, 0: 0}
+	for in, want := range cases {
+		if got := installsBucketMax(in); got != want {
+			t.Errorf("%d: got %d want %d", in, got, want)
+		}
+	}
+}
+
+func TestListItemInstalls(t *testing.T) {
+	var item any
+	item = setFixturePath(item, "com.a", 0, 0)
+	item = setFixturePath(item, "App A", 3)
+	item = setFixturePath(item, "1 Mio.+", 15)
+	fields := extractFields(parsedData{"root": item}, prefixMappings(clusterItemMappings()))
+	fillFromText(fields, "de")
+	apps, err := appsFromMaps([]map[string]any{fields})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := apps[0]
+	if a.Installs == nil || *a.Installs != "1 Mio.+" || a.MinInstalls == nil || *a.MinInstalls != 1000000 || a.MaxInstalls == nil || *a.MaxInstalls != 4999999 {
+		t.Fatalf("unexpected cluster item installs %+v", a)
+	}
+
+	var card any
+	card = setFixturePath(card, "com.b", 12, 0)
+	card = setFixturePath(card, "10K+", 6, 0, 3, 1, 0)
+	other := setFixturePath(nil, "com.c", 12, 0)
+	other = setFixturePath(other, "Editors' choice", 6, 0, 3, 1, 0)
+	maps := extractAppList([]any{"ds:4"}, parsedData{"ds:4": []any{card, other}}, "en")
+	apps, err = appsFromMaps(maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apps[0].MinInstalls == nil || *apps[0].MinInstalls != 10000 || *apps[0].MaxInstalls != 49999 {
+		t.Fatalf("unexpected search item installs %+v", apps[0])
+	}
+	if apps[1].Installs != nil || apps[1].MinInstalls != nil {
+		t.Fatalf("non-install text mapped as installs %+v", apps[1])
+	}
+}
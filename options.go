@@ -19,10 +19,38 @@ type AppOptions struct {
 	Country string
 }
 
+type AppLocalesOptions struct {
+	CallOptions
+	AppID       string
+	Locales     []Locale
+	Base        Locale
+	Concurrency int
+}
+
+type AvailabilityOptions struct {
+	CallOptions
+	AppID       string
+	Lang        string
+	Countries   []string
+	Concurrency int
+}
+
+type TrackRanksOptions struct {
+	CallOptions
+	AppIDs      []string
+	Keywords    []string
+	Countries   []string
+	Lang        string
+	Num         int
+	Concurrency int
+	Store       RankStore
+}
+
 type ListOptions struct {
 	CallOptions
 	Collection Collection
 	Category   Category
+	Device     Device
 	Age        *Age
 	Num        int
 	Lang       string
@@ -30,6 +58,26 @@ type ListOptions struct {
 	FullDetail bool
 }
 
+type SnapshotChartsOptions struct {
+	CallOptions
+	Collections []Collection
+	Categories  []Category
+	Countries   []string
+	Device      Device
+	Lang        string
+	Num         int
+	Concurrency int
+	Store       ChartStore
+}
+
+type ShelvesOptions struct {
+	CallOptions
+	Category Category
+	Age      *Age
+	Lang     string
+	Country  string
+}
+
 type SearchPrice string
 
 const (
@@ -38,14 +86,33 @@ const (
 	SearchPricePaid SearchPrice = "paid"
 )
 
+type SearchRating string
+
+const (
+	SearchRatingAll      SearchRating = ""
+	SearchRatingFourPlus SearchRating = "4+"
+)
+
+type SearchContentRating string
+
+const (
+	SearchContentRatingAll      SearchContentRating = ""
+	SearchContentRatingEveryone SearchContentRating = "everyone"
+	SearchContentRatingTeen     SearchContentRating = "teen"
+	SearchContentRatingMature   SearchContentRating = "mature"
+)
+
 type SearchOptions struct {
 	CallOptions
-	Term       string
-	Num        int
-	Lang       string
-	Country    string
-	FullDetail bool
-	Price      SearchPrice
+	Term          string
+	Num           int
+	Lang          string
+	Country       string
+	FullDetail    bool
+	Price         SearchPrice
+	Rating        SearchRating
+	ContentRating SearchContentRating
+	Device        Device
 }
 
 type DeveloperOptions struct {
@@ -64,6 +131,16 @@ type SuggestOptions struct {
 	Country string
 }
 
+type ExpandKeywordsOptions struct {
+	CallOptions
+	Seed     string
+	Lang     string
+	Country  string
+	Depth    int
+	Budget   int
+	Alphabet string
+}
+
 type ReviewsOptions struct {
 	CallOptions
 	AppID               string
@@ -84,6 +161,22 @@ type SimilarOptions struct {
 	Num        int
 }
 
+type ClusterOptions struct {
+	CallOptions
+	URL        string
+	Lang       string
+	Country    string
+	FullDetail bool
+	Num        int
+}
+
+type RelatedClustersOptions struct {
+	CallOptions
+	AppID   string
+	Lang    string
+	Country string
+}
+
 type PermissionsOptions struct {
 	CallOptions
 	AppID   string
@@ -92,6 +185,16 @@ type PermissionsOptions struct {
 	Short   bool
 }
 
+type SnapshotOptions struct {
+	CallOptions
+	AppID       string
+	Lang        string
+	Country     string
+	Permissions bool
+	DataSafety  bool
+	Store       SnapshotStore
+}
+
 type DataSafetyOptions struct {
 	CallOptions
 	AppID string
@@ -101,3 +204,40 @@ type DataSafetyOptions struct {
 type CategoriesOptions struct {
 	CallOptions
 }
+
+type CategoryTaxonomyOptions struct {
+	CallOptions
+	Lang    string
+	Country string
+}
+
+type CrawlOptions struct {
+	CallOptions
+	SeedApps        []string
+	SeedTerms       []string
+	SeedCategories  []Category
+	Collections     []Collection
+	Lang            string
+	Country         string
+	MaxDepth        int
+	Budget          int
+	SkipSimilar     bool
+	SkipDeveloper   bool
+	SearchNum       int
+	ListNum         int
+	Checkpoint      string
+	CheckpointEvery int
+	Sink            AppSink
+}
+
+type GraphOptions struct {
+	CallOptions
+	SeedApps      []string
+	Lang          string
+	Country       string
+	Depth         int
+	MaxNodes      int
+	SkipSimilar   bool
+	SkipDeveloper bool
+	FullDetail    bool
+}
@@ -70,7 +70,7 @@ func checkFinished(ctx context.Context, c *Client, opts CallOptions, lang, count
 }
 
 func processPages(ctx context.Context, c *Client, opts CallOptions, lang, country string, num int, saved []map[string]any, data any, mappings pageMappings) ([]map[string]any, error) {
-	apps := extractAppList(mappings.Apps, data)
+	apps := extractAppList(mappings.Apps, data, lang)
 	tokenVal, _ := asString(pathGet(data, mappings.Token))
 	all := append(saved, apps...)
 	return checkFinished(ctx, c, opts, lang, country, num, all, tokenVal, mappings)
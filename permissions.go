@@ -3,7 +3,6 @@ package gplay
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 )
@@ -12,7 +11,7 @@ var permissionsURLTemplate = "/_/PlayStoreUi/data/batchexecute?rpcids=qnKhOb&f.s
 
 func (c *Client) Permissions(ctx context.Context, opts PermissionsOptions) (PermissionsResult, error) {
 	if opts.AppID == "" {
-		return PermissionsResult{}, errors.New("appId missing")
+		return PermissionsResult{}, optionError("appId missing")
 	}
 	lang := opts.Lang
 	if lang == "" {
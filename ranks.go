@@ -0,0 +1,213 @@
+package gplay
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type KeywordRank struct {
+	AppID            string    `json:"appId"`
+	Keyword          string    `json:"keyword"`
+	Country          string    `json:"country"`
+	Lang             string    `json:"lang"`
+	Found            bool      `json:"found"`
+	Position         int       `json:"position"`
+	AbsolutePosition int       `json:"absolutePosition"`
+	Sponsored        bool      `json:"sponsored"`
+	Scanned          int       `json:"scanned"`
+	CheckedAt        time.Time `json:"checkedAt"`
+}
+
+type RankQuery struct {
+	AppID   string
+	Keyword string
+	Country string
+	Since   time.Time
+	Until   time.Time
+}
+
+func (q RankQuery) matches(r KeywordRank) bool {
+	if q.AppID != "" && q.AppID != r.AppID {
+		return false
+	}
+	if q.Keyword != "" && q.Keyword != r.Keyword {
+		return false
+	}
+	if q.Country != "" && q.Country != r.Country {
+		return false
+	}
+	if !q.Since.IsZero() && r.CheckedAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && r.CheckedAt.After(q.Until) {
+		return false
+	}
+	return true
+}
+
+type RankStore interface {
+	SaveRanks(ctx context.Context, ranks []KeywordRank) error
+	Ranks(ctx context.Context, q RankQuery) ([]KeywordRank, error)
+}
+
+type MemoryRankStore struct {
+	mu    sync.Mutex
+	ranks []KeywordRank
+}
+
+func (s *MemoryRankStore) SaveRanks(_ context.Context, ranks []KeywordRank) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ranks = append(s.ranks, ranks...)
+	return nil
+}
+
+func (s *MemoryRankStore) Ranks(_ context.Context, q RankQuery) ([]KeywordRank, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return filterRecords(s.ranks, q.matches), nil
+}
+
+type FileRankStore struct {
+	Path string
+	mu   sync.Mutex
+}
+
+func (s *FileRankStore) SaveRanks(_ context.Context, ranks []KeywordRank) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return appendNDJSON(s.Path, ranks)
+}
+
+func (s *FileRankStore) Ranks(_ context.Context, q RankQuery) ([]KeywordRank, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return scanNDJSON(s.Path, q.matches)
+}
+
+func (c *Client) TrackRanks(ctx context.Context, opts TrackRanksOptions) ([]KeywordRank, error) {
+	if len(opts.AppIDs) == 0 {
+		return nil, optionError("appIds missing")
+	}
+	if len(opts.Keywords) == 0 {
+		return nil, optionError("keywords missing")
+	}
+	if opts.Num > 250 {
+		return nil, optionError("The number of results can't exceed 250")
+	}
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	countries := opts.Countries
+	if len(countries) == 0 {
+		countries = []string{"us"}
+	}
+	num := opts.Num
+	if num == 0 {
+		num = 250
+	}
+
+	type job struct{ keyword, country string }
+	jobs := make([]job, 0, len(opts.Keywords)*len(countries))
+	for _, kw := range opts.Keywords {
+		for _, cc := range countries {
+			jobs = append(jobs, job{kw, cc})
+		}
+	}
+
+	results := make([][]KeywordRank, len(jobs))
+	err := fanOut(ctx, len(jobs), opts.Concurrency, func(ctx context.Context, i int) error {
+		j := jobs[i]
+		res, err := c.SearchDetailed(ctx, SearchOptions{CallOptions: opts.CallOptions, Term: j.keyword, Lang: lang, Country: j.country, Num: num})
+		if err != nil {
+			return err
+		}
+		apps := res.Apps
+		if res.Featured != nil {
+			apps = append([]App{res.Featured.App}, res.Apps...)
+		}
+		results[i] = rankApps(apps, opts.AppIDs, j.keyword, j.country, lang, time.Now().UTC())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]KeywordRank, 0, len(jobs)*len(opts.AppIDs))
+	for _, rs := range results {
+		out = append(out, rs...)
+	}
+	if opts.Store != nil {
+		if err := opts.Store.SaveRanks(ctx, out); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func rankApps(apps []App, appIDs []string, keyword, country, lang string, at time.Time) []KeywordRank {
+	out := make([]KeywordRank, 0, len(appIDs))
+	for _, id := range appIDs {
+		r := KeywordRank{AppID: id, Keyword: keyword, Country: country, Lang: lang, Scanned: len(apps), CheckedAt: at}
+		for i, a := range apps {
+			if a.AppID != id {
+				continue
+			}
+			if a.Sponsored {
+				r.Sponsored = true
+				continue
+			}
+			r.Found = true
+			r.AbsolutePosition = i + 1
+			r.Position = i + 1
+			if a.Position != nil {
+				r.AbsolutePosition = a.Position.Absolute
+				r.Position = a.Position.Organic
+			}
+			break
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+type RankChange struct {
+	AppID    string `json:"appId"`
+	Keyword  string `json:"keyword"`
+	Country  string `json:"country"`
+	Previous *int   `json:"previous"`
+	Current  *int   `json:"current"`
+	Delta    int    `json:"delta"`
+}
+
+func CompareRanks(previous, current []KeywordRank) []RankChange {
+	key := func(r KeywordRank) string { return r.AppID + "\x00" + r.Keyword + "\x00" + r.Country }
+	position := func(r KeywordRank) *int {
+		if !r.Found {
+			return nil
+		}
+		p := r.Position
+		return &p
+	}
+	prev := make(map[string]KeywordRank, len(previous))
+	for _, r := range previous {
+		if old, ok := prev[key(r)]; !ok || r.CheckedAt.After(old.CheckedAt) {
+			prev[key(r)] = r
+		}
+	}
+
+	out := make([]RankChange, 0, len(current))
+	for _, r := range current {
+		ch := RankChange{AppID: r.AppID, Keyword: r.Keyword, Country: r.Country, Current: position(r)}
+		if p, ok := prev[key(r)]; ok {
+			ch.Previous = position(p)
+		}
+		if ch.Previous != nil && ch.Current != nil {
+			ch.Delta = *ch.Previous - *ch.Current
+		}
+		out = append(out, ch)
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+package gplay
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRankApps(t *testing.T) {
+	apps := []App{
+		{AppID: "com.b", Sponsored: true, Position: &ResultPosition{Absolute: 1}},
+		{AppID: "com.a", Position: &ResultPosition{Absolute: 2, Organic: 1}},
+		{AppID: "com.b", Position: &ResultPosition{Absolute: 3, Organic: 2}},
+	}
+	ranks := rankApps(apps, []string{"com.a", "com.b", "com.c"}, "kw", "us", "en", time.Unix(0, 0))
+	if !ranks[0].Found || ranks[0].Position != 1 || ranks[0].AbsolutePosition != 2 {
+		t.Fatalf("unexpected rank %+v", ranks[0])
+	}
+	if !ranks[1].Found || !ranks[1].Sponsored || ranks[1].Position != 2 || ranks[1].AbsolutePosition != 3 {
+		t.Fatalf("unexpected rank %+v", ranks[1])
+	}
+	if ranks[2].Found || ranks[2].Scanned != 3 {
+		t.Fatalf("unexpected rank %+v", ranks[2])
+	}
+}
+
+func TestRankAppsFeatured(t *testing.T) {
+	apps := []App{
+		{AppID: "com.featured", Position: &ResultPosition{Absolute: 1, Organic: 1}},
+		{AppID: "com.a", Position: &ResultPosition{Absolute: 2, Organic: 2}},
+	}
+	ranks := rankApps(apps, []string{"com.featured", "com.a"}, "kw", "us", "en", time.Unix(0, 0))
+	if !ranks[0].Found || ranks[0].Position != 1 || ranks[0].AbsolutePosition != 1 {
+		t.Fatalf("unexpected featured rank %+v", ranks[0])
+	}
+	if !ranks[1].Found || ranks[1].Position != 2 || ranks[1].Scanned != 2 {
+		t.Fatalf("unexpected rank %+v", ranks[1])
+	}
+}
+
+func TestFileRankStoreAndCompare(t *testing.T) {
+	ctx := context.Background()
+	store := &FileRankStore{Path: filepath.Join(t.TempDir(), "ranks.ndjson")}
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	if err := store.SaveRanks(ctx, []KeywordRank{
+		{AppID: "com.a", Keyword: "kw", Country: "us", Found: true, Position: 5, CheckedAt: day1},
+		{AppID: "com.b", Keyword: "kw", Country: "us", CheckedAt: day1},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	prev, err := store.Ranks(ctx, RankQuery{Until: day1})
+	if err != nil || len(prev) != 2 {
+		t.Fatalf("unexpected ranks %v %v", prev, err)
+	}
+	changes := CompareRanks(prev, []KeywordRank{
+		{AppID: "com.a", Keyword: "kw", Country: "us", Found: true, Position: 2, CheckedAt: day2},
+		{AppID: "com.b", Keyword: "kw", Country: "us", Found: true, Position: 9, CheckedAt: day2},
+	})
+	if changes[0].Delta != 3 || changes[1].Previous != nil || *changes[1].Current != 9 {
+		t.Fatalf("unexpected changes %+v", changes)
+	}
+}
@@ -0,0 +1,198 @@
+package gplay
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+type ClusterType string
+
+const (
+	ClusterSimilar         ClusterType = "similar"
+	ClusterMoreByDeveloper ClusterType = "more_by_developer"
+	ClusterSponsored       ClusterType = "sponsored"
+	ClusterOther           ClusterType = "other"
+)
+
+type AppCluster struct {
+	Title  string      `json:"title"`
+	Type   ClusterType `json:"type"`
+	URL    string      `json:"url"`
+	AppIDs []string    `json:"appIds"`
+}
+
+var similarClusterTitles = map[string]bool{"Similar apps": true, "Similar games": true}
+
+func (c *Client) RelatedClusters(ctx context.Context, opts RelatedClustersOptions) ([]AppCluster, error) {
+	if opts.AppID == "" {
+		return nil, optionError("appId missing")
+	}
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	country := opts.Country
+	if country == "" {
+		country = "us"
+	}
+	cacheOpts := opts
+	cacheOpts.Lang = lang
+	cacheOpts.Country = country
+	if c != nil && c.cache != nil {
+		var cached []AppCluster
+		hit, err := c.cacheGet("relatedClusters", cacheOpts, &cached)
+		if err != nil {
+			return nil, err
+		}
+		if hit {
+			return cached, nil
+		}
+	}
+
+	clusters, err := c.detailsClusters(ctx, opts.CallOptions, opts.AppID, lang, country)
+	if err != nil {
+		return nil, err
+	}
+	c.cacheSet("relatedClusters", cacheOpts, clusters)
+	return clusters, nil
+}
+
+func (c *Client) detailsClusters(ctx context.Context, callOpts CallOptions, appID, lang, country string) ([]AppCluster, error) {
+	qs := url.Values{}
+	qs.Set("id", appID)
+	qs.Set("hl", lang)
+	qs.Set("gl", country)
+	pageURL := "/store/apps/details?" + encodeValues(qs)
+	body, _, err := c.do(ctx, requestOptions{URL: pageURL, Headers: callOpts.Headers}, callOpts.Throttle)
+	if err != nil {
+		return nil, err
+	}
+	clusters := classifyClusters(parseScriptData(body))
+	if lang == "en" || hasClusterType(clusters, ClusterSimilar) {
+		return clusters, nil
+	}
+	// Cluster titles are localized, so find the similar cluster on the
+	// English page and match it by its cluster token.
+	english, err := c.detailsClusters(ctx, callOpts, appID, "en", country)
+	if err != nil {
+		return nil, err
+	}
+	markSimilarClusters(clusters, english)
+	return clusters, nil
+}
+
+func hasClusterType(clusters []AppCluster, typ ClusterType) bool {
+	for _, cl := range clusters {
+		if cl.Type == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func clusterKey(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return ""
+	}
+	gsr := parsed.Query().Get("gsr")
+	if gsr == "" {
+		return ""
+	}
+	return parsed.Path + "?gsr=" + gsr
+}
+
+func markSimilarClusters(clusters, reference []AppCluster) {
+	similar := map[string]bool{}
+	for _, cl := range reference {
+		if key := clusterKey(cl.URL); cl.Type == ClusterSimilar && key != "" {
+			similar[key] = true
+		}
+	}
+	for i := range clusters {
+		if clusters[i].Type == ClusterOther && similar[clusterKey(clusters[i].URL)] {
+			clusters[i].Type = ClusterSimilar
+		}
+	}
+}
+
+func classifyClusters(parsed parsedData) []AppCluster {
+	clustersAny := extractDataWithServiceRequestID(parsed, serviceRequestSpec{Path: []any{1, 1}, UseServiceRequestID: "ag2B9c"})
+	raw, _ := clustersAny.([]any)
+	developer, _ := asString(pathGet(parsed, []any{"ds:5", 1, 2, 68, 0}))
+
+	out := make([]AppCluster, 0, len(raw))
+	for _, cl := range raw {
+		title, _ := asString(pathGet(cl, []any{21, 1, 0}))
+		link, _ := asString(pathGet(cl, []any{21, 1, 2, 4, 2}))
+		if title == "" && link == "" {
+			continue
+		}
+		items, _ := pathGet(cl, []any{21, 0}).([]any)
+		ids := make([]string, 0, len(items))
+		sameDeveloper := len(items) > 0 && developer != ""
+		for _, it := range items {
+			if id, ok := asString(pathGet(it, []any{0, 0})); ok {
+				ids = append(ids, id)
+			}
+			if dev, _ := asString(pathGet(it, []any{14})); dev != developer {
+				sameDeveloper = false
+			}
+		}
+
+		typ := ClusterOther
+		switch {
+		case containsAdMarker(cl):
+			typ = ClusterSponsored
+		case strings.HasPrefix(link, "/store/apps/dev"), sameDeveloper:
+			typ = ClusterMoreByDeveloper
+		case similarClusterTitles[title]:
+			typ = ClusterSimilar
+		}
+		var u string
+		if link != "" {
+			u = resolveURL(BaseURL, link)
+		}
+		out = append(out, AppCluster{Title: title, Type: typ, URL: u, AppIDs: ids})
+	}
+	return out
+}
+
+var sponsoredSpec = fieldSpec{Fn: func(input any, _ parsedData) any { return containsAdMarker(input) }}
+
+func assignPositions(maps []map[string]any) {
+	assignPositionsAfter(maps, 0)
+}
+
+func assignPositionsAfter(maps []map[string]any, start int) {
+	organic := start
+	for i, m := range maps {
+		pos := map[string]any{"absolute": start + i + 1, "organic": 0}
+		if sponsored, _ := m["sponsored"].(bool); !sponsored {
+			organic++
+			pos["organic"] = organic
+		}
+		m["position"] = pos
+	}
+}
+
+func containsAdMarker(v any) bool {
+	switch t := v.(type) {
+	case string:
+		return strings.Contains(t, "googleadservices.com") || strings.Contains(t, "/pagead/") || strings.Contains(t, "/aclk?")
+	case []any:
+		for _, sub := range t {
+			if containsAdMarker(sub) {
+				return true
+			}
+		}
+	case map[string]any:
+		for _, sub := range t {
+			if containsAdMarker(sub) {
+				return true
+			}
+		}
+	}
+	return false
+}
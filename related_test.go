@@ -0,0 +1,87 @@
+package gplay
+
+import "testing"
+
+func TestClassifyClusters(t *testing.T) {
+	cluster := func(title, link string, devs ...string) any {
+		items := make([]any, 0, len(devs))
+		for i, d := range devs {
+			item := make([]any, 15)
+			item[0] = []any{"app" + string(rune('a'+i))}
+			item[14] = d
+			items = append(items, item)
+		}
+		node := make([]any, 22)
+		node[21] = []any{items, []any{title, nil, []any{nil, nil, nil, nil, []any{nil, nil, link}}}}
+		return node
+	}
+	details := make([]any, 69)
+	details[68] = []any{"Acme"}
+	pd := parsedData{
+		"ds:5": []any{nil, []any{nil, nil, details}},
+		"ds:7": []any{nil, []any{nil, []any{
+			cluster("Más de Acme", "/store/apps/collection/cluster?gsr=dev", "Acme", "Acme"),
+			cluster("Juegos similares", "/store/apps/collection/cluster?gsr=sim", "Foo", "Bar"),
+			cluster("Anuncios", "https://www.googleadservices.com/pagead/aclk?x", "Baz"),
+			cluster("Otros", "/store/apps/collection/cluster?gsr=other", "Qux"),
+		}}},
+		"serviceRequestData": map[string]any{"ds:7": map[string]any{"id": "ag2B9c"}},
+	}
+
+	got := classifyClusters(pd)
+	want := []ClusterType{ClusterMoreByDeveloper, ClusterOther, ClusterSponsored, ClusterOther}
+	for i, w := range want {
+		if got[i].Type != w {
+			t.Fatalf("localized cluster %d (%s) should not be guessed: got %s want %s", i, got[i].Title, got[i].Type, w)
+		}
+	}
+
+	markSimilarClusters(got, []AppCluster{
+		{Title: "Similar games", Type: ClusterSimilar, URL: BaseURL + "/store/apps/collection/cluster?gsr=sim&hl=en"},
+		{Title: "Others", Type: ClusterOther, URL: BaseURL + "/store/apps/collection/cluster?gsr=other&hl=en"},
+	})
+	want = []ClusterType{ClusterMoreByDeveloper, ClusterSimilar, ClusterSponsored, ClusterOther}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d clusters, got %d", len(want), len(got))
+	}
+	for i, w := range want {
+		if got[i].Type != w {
+			t.Errorf("cluster %d (%s): got %s want %s", i, got[i].Title, got[i].Type, w)
+		}
+	}
+	if got[1].URL != BaseURL+"/store/apps/collection/cluster?gsr=sim" || len(got[1].AppIDs) != 2 {
+		t.Fatalf("unexpected similar cluster %+v", got[1])
+	}
+}
+
+func TestSponsoredPositions(t *testing.T) {
+	m := prefixMappings(clusterItemMappings())
+	items := []any{
+		[]any{[]any{"com.ad.app"}, nil, nil, "Ad", nil, nil, nil, nil, nil, nil, []any{nil, nil, nil, nil, []any{nil, nil, "https://www.googleadservices.com/pagead/aclk?sa=L"}}},
+		[]any{[]any{"com.organic.one"}, nil, nil, "One"},
+		[]any{[]any{"com.organic.two"}, nil, nil, "Two"},
+	}
+	maps := make([]map[string]any, 0, len(items))
+	for _, it := range items {
+		maps = append(maps, extractFields(parsedData{"root": it}, m))
+	}
+	assignPositions(maps)
+	apps, err := appsFromMaps(maps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !apps[0].Sponsored || apps[1].Sponsored || apps[2].Sponsored {
+		t.Fatalf("unexpected sponsored flags %v %v %v", apps[0].Sponsored, apps[1].Sponsored, apps[2].Sponsored)
+	}
+	if *apps[0].Position != (ResultPosition{Absolute: 1}) || *apps[2].Position != (ResultPosition{Absolute: 3, Organic: 2}) {
+		t.Fatalf("unexpected positions %+v %+v", apps[0].Position, apps[2].Position)
+	}
+}
+
+func TestMarkSimilarClustersWithoutMatch(t *testing.T) {
+	clusters := []AppCluster{{Type: ClusterOther, URL: BaseURL + "/store/apps/collection/cluster?gsr=other&hl=de"}}
+	markSimilarClusters(clusters, []AppCluster{{Type: ClusterSimilar, URL: BaseURL + "/store/apps/collection/cluster?gsr=sim&hl=en"}})
+	if clusters[0].Type != ClusterOther {
+		t.Fatalf("unrelated cluster marked similar %+v", clusters[0])
+	}
+}
@@ -41,6 +41,24 @@ func (e *RequestError) Unwrap() error {
 	return e.Err
 }
 
+var ErrInvalidOption = errors.New("invalid option")
+
+type OptionError struct {
+	Message string
+}
+
+func (e *OptionError) Error() string {
+	return e.Message
+}
+
+func (e *OptionError) Is(target error) bool {
+	return target == ErrInvalidOption
+}
+
+func optionError(msg string) error {
+	return &OptionError{Message: msg}
+}
+
 func (c *Client) doRequest(ctx context.Context, opts requestOptions, throttlePerSecond int) ([]byte, int, error) {
 	if ctx == nil {
 		ctx = context.Background()
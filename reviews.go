@@ -3,7 +3,6 @@ package gplay
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -12,7 +11,7 @@ import (
 
 func (c *Client) Reviews(ctx context.Context, opts ReviewsOptions) (ReviewsResult, error) {
 	if opts.AppID == "" {
-		return ReviewsResult{}, errors.New("appId missing")
+		return ReviewsResult{}, optionError("appId missing")
 	}
 	lang := opts.Lang
 	if lang == "" {
@@ -2,17 +2,32 @@ package gplay
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"net/url"
+	"strings"
 )
 
+type SearchResult struct {
+	Query           string          `json:"query"`
+	CorrectedQuery  *string         `json:"correctedQuery"`
+	RelatedSearches []string        `json:"relatedSearches"`
+	Featured        *FeaturedResult `json:"featured"`
+	Apps            []App           `json:"apps"`
+}
+
 func (c *Client) Search(ctx context.Context, opts SearchOptions) ([]App, error) {
+	res, err := c.SearchDetailed(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return res.Apps, nil
+}
+
+func (c *Client) SearchDetailed(ctx context.Context, opts SearchOptions) (SearchResult, error) {
 	if opts.Term == "" {
-		return nil, errors.New("Search term missing")
+		return SearchResult{}, optionError("Search term missing")
 	}
 	if opts.Num > 0 && opts.Num > 250 {
-		return nil, errors.New("The number of results can't exceed 250")
+		return SearchResult{}, optionError("The number of results can't exceed 250")
 	}
 	lang := opts.Lang
 	if lang == "" {
@@ -31,10 +46,10 @@ func (c *Client) Search(ctx context.Context, opts SearchOptions) ([]App, error)
 	cacheOpts.Country = country
 	cacheOpts.Num = num
 	if c != nil && c.cache != nil {
-		var cached []App
+		var cached SearchResult
 		hit, err := c.cacheGet("search", cacheOpts, &cached)
 		if err != nil {
-			return nil, err
+			return SearchResult{}, err
 		}
 		if hit {
 			return cached, nil
@@ -56,17 +71,27 @@ func (c *Client) Search(ctx context.Context, opts SearchOptions) ([]App, error)
 	qs.Set("hl", lang)
 	qs.Set("gl", country)
 	qs.Set("price", string(rune('0'+price)))
+	if err := setSearchFilters(qs, opts); err != nil {
+		return SearchResult{}, err
+	}
 	pageURL := "/work/search?" + encodeValues(qs)
 	body, _, err := c.do(ctx, requestOptions{URL: pageURL, Headers: opts.Headers}, opts.Throttle)
 	if err != nil {
-		return nil, err
+		return SearchResult{}, err
 	}
 
 	parsed := parseScriptData(body)
+	res := SearchResult{Query: opts.Term}
+	res.CorrectedQuery, res.RelatedSearches = extractSearchRefinements(parsed, opts.Term)
+	res.Featured, err = extractFeatured(parsed, opts.Term, lang)
+	if err != nil {
+		return SearchResult{}, err
+	}
+
 	sectionsAny := pathGet(parsed, []any{"ds:1", 0, 1, 0, 0})
 	sections, _ := sectionsAny.([]any)
 	if len(sections) == 0 {
-		return nil, nil
+		return res, nil
 	}
 
 	appsAny := pathGet(parsed, []any{"ds:1", 0, 1, 0, 0, 0})
@@ -94,49 +119,138 @@ func (c *Client) Search(ctx context.Context, opts SearchOptions) ([]App, error)
 		"developer":   {Path: []any{4, 0, 0, 0}},
 		"developerId": {Path: []any{4, 0, 0, 1, 4, 2}, Fn: func(input any, _ parsedData) any { s, _ := asString(input); return extractDeveloperID(s) }},
 		"currency":    {Path: []any{7, 0, 3, 2, 1, 0, 1}},
-		"price":       {Path: []any{7, 0, 3, 2, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v / 1000000 }},
+		"price":       {Path: []any{7, 0, 3, 2, 1, 0, 0}, Fn: priceFromMicros},
+		"priceMicros": {Path: []any{7, 0, 3, 2, 1, 0, 0}, Fn: priceMicros},
+		"priceText":   {Path: []any{7, 0, 3, 2, 1, 0, 2}},
 		"free":        {Path: []any{7, 0, 3, 2, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v == 0 }},
 		"summary":     {Path: []any{4, 1, 1, 1, 1}},
 		"scoreText":   {Path: []any{6, 0, 2, 1, 0}},
 		"score":       {Path: []any{6, 0, 2, 1, 1}},
+		"installs":    {Path: []any{6, 0, 3, 1, 0}, Fn: installsText},
+		"sponsored":   sponsoredSpec,
 	}
 
 	appMaps := make([]map[string]any, 0, len(appsArr))
 	for _, it := range appsArr {
 		fields := extractFields(parsedData{"root": it}, prefixMappings(m))
+		fillFromText(fields, lang)
 		appMaps = append(appMaps, fields)
 	}
 
 	page := pageMappings{Apps: []any{0, 0, 0}, Token: []any{0, 0, 7, 1}}
 	more, err := checkFinished(ctx, c, opts.CallOptions, lang, country, num, appMaps, token, page)
 	if err != nil {
-		return nil, err
+		return SearchResult{}, err
+	}
+	// The featured card is the first result on the page, so it takes
+	// position 1 and the list is numbered after it.
+	start := 0
+	if res.Featured != nil {
+		res.Featured.Position = &ResultPosition{Absolute: 1, Organic: 1}
+		start = 1
+	}
+	assignPositionsAfter(more, start)
+	apps, err := appsFromMaps(more)
+	if err != nil {
+		return SearchResult{}, err
 	}
-	apps := make([]App, 0, len(more))
-	for _, m := range more {
-		b, err := json.Marshal(m)
+
+	if opts.FullDetail {
+		res.Apps, err = c.fullDetailApps(ctx, opts.CallOptions, apps, lang, country)
 		if err != nil {
-			return nil, err
+			return SearchResult{}, err
 		}
-		var a App
-		if err := json.Unmarshal(b, &a); err != nil {
-			return nil, err
+		return res, nil
+	}
+
+	res.Apps = apps
+	c.cacheSet("search", cacheOpts, res)
+	return res, nil
+}
+
+func setSearchFilters(qs url.Values, opts SearchOptions) error {
+	switch opts.Rating {
+	case SearchRatingAll:
+	case SearchRatingFourPlus:
+		qs.Set("rating", "1")
+	default:
+		return optionError("Invalid rating filter " + string(opts.Rating))
+	}
+	switch opts.ContentRating {
+	case SearchContentRatingAll:
+	case SearchContentRatingEveryone, SearchContentRatingTeen, SearchContentRatingMature:
+		qs.Set("content", string(opts.ContentRating))
+	default:
+		return optionError("Invalid content rating filter " + string(opts.ContentRating))
+	}
+	switch opts.Device {
+	case "", DevicePhone:
+	case DeviceTablet, DeviceWear, DeviceTV, DeviceChromebook, DeviceCar:
+		qs.Set("device", string(opts.Device))
+	default:
+		return optionError("Invalid device " + string(opts.Device))
+	}
+	return nil
+}
+
+func extractSearchRefinements(parsed parsedData, term string) (*string, []string) {
+	blocks, _ := pathGet(parsed, []any{"ds:1", 0, 1}).([]any)
+	var corrected *string
+	related := make([]string, 0)
+	seen := map[string]bool{strings.ToLower(term): true}
+	for i, block := range blocks {
+		var scan []any
+		if i == 0 {
+			head, _ := pathGet(block, []any{0}).([]any)
+			if len(head) > 1 {
+				scan = head[1:]
+			}
+		} else {
+			scan = []any{block}
+		}
+		for _, q := range searchLinkQueries(scan) {
+			key := strings.ToLower(q)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			if i == 0 && corrected == nil {
+				qc := q
+				corrected = &qc
+				continue
+			}
+			related = append(related, q)
 		}
-		apps = append(apps, a)
 	}
+	return corrected, related
+}
 
-	if opts.FullDetail {
-		out := make([]App, 0, len(apps))
-		for _, a := range apps {
-			full, err := c.App(ctx, AppOptions{CallOptions: opts.CallOptions, AppID: a.AppID, Lang: lang, Country: country})
+func searchLinkQueries(v any) []string {
+	var out []string
+	var walk func(any)
+	walk = func(v any) {
+		switch t := v.(type) {
+		case string:
+			if !strings.HasPrefix(t, "/store/search?") && !strings.HasPrefix(t, BaseURL+"/store/search?") {
+				return
+			}
+			u, err := url.Parse(t)
 			if err != nil {
-				return nil, err
+				return
+			}
+			if q := u.Query().Get("q"); q != "" {
+				out = append(out, q)
+			}
+		case []any:
+			for _, sub := range t {
+				walk(sub)
+			}
+		case map[string]any:
+			for _, sub := range t {
+				walk(sub)
 			}
-			out = append(out, full)
 		}
-		return out, nil
 	}
-
-	c.cacheSet("search", cacheOpts, apps)
-	return apps, nil
+	walk(v)
+	return out
 }
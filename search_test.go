@@ -0,0 +1,121 @@
+package gplay
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestExtractSearchRefinements(t *testing.T) {
+	pd := parsedData{
+		"ds:1": []any{[]any{nil, []any{
+			[]any{[]any{
+				[]any{[]any{"app"}},
+				[]any{"Showing results for", []any{nil, nil, "/store/search?q=netflix&c=apps"}},
+			}},
+			[]any{"Related searches", []any{
+				[]any{"netflix app", "/store/search?q=netflix%20app&c=apps"},
+				[]any{"Netflix", "/store/search?q=Netflix&c=apps"},
+				[]any{"movies", "https://play.google.com/store/search?q=movies&c=apps"},
+			}},
+		}}},
+	}
+	corrected, related := extractSearchRefinements(pd, "netflx")
+	if corrected == nil || *corrected != "netflix" {
+		t.Fatalf("unexpected corrected query %v", corrected)
+	}
+	if len(related) != 2 || related[0] != "netflix app" || related[1] != "movies" {
+		t.Fatalf("unexpected related searches %v", related)
+	}
+}
+
+func TestSetSearchFilters(t *testing.T) {
+	qs := url.Values{}
+	err := setSearchFilters(qs, SearchOptions{Rating: SearchRatingFourPlus, ContentRating: SearchContentRatingTeen, Device: DeviceTablet})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qs.Get("rating") != "1" || qs.Get("content") != "teen" || qs.Get("device") != "tablet" {
+		t.Fatalf("unexpected query %v", qs)
+	}
+	if err := setSearchFilters(url.Values{}, SearchOptions{Rating: "3+"}); err == nil {
+		t.Fatalf("expected invalid rating error")
+	}
+}
+
+func TestFeaturedExactAndBadges(t *testing.T) {
+	yes := true
+	app := App{AppID: "com.spotify.music", Title: "Spotify: Music and Podcasts", AdSupported: &yes, OffersIAP: &yes}
+	if isExactHit(app, "spotify") {
+		t.Fatalf("partial title should not be exact")
+	}
+	if !isExactHit(app, "spotify:  music and podcasts") || !isExactHit(app, "com.spotify.music") {
+		t.Fatalf("expected exact hit")
+	}
+	badges := appBadges(app)
+	if len(badges) != 2 || badges[0] != BadgeContainsAds || badges[1] != BadgeInAppPurchases {
+		t.Fatalf("unexpected badges %v", badges)
+	}
+}
+
+func setFixturePath(node any, v any, path ...int) any {
+	if len(path) == 0 {
+		return v
+	}
+	arr, _ := node.([]any)
+	if len(arr) <= path[0] {
+		arr = append(arr, make([]any, path[0]+1-len(arr))...)
+	}
+	arr[path[0]] = setFixturePath(arr[path[0]], v, path[1:]...)
+	return arr
+}
+
+func TestExtractFeatured(t *testing.T) {
+	var card any
+	card = setFixturePath(card, "Spotify: Music and Podcasts", 16, 2, 0, 0)
+	card = setFixturePath(card, "Spotify AB", 16, 2, 68, 0)
+	card = setFixturePath(card, "1B+", 16, 2, 13, 0)
+	card = setFixturePath(card, 4.3, 16, 2, 51, 0, 1)
+	card = setFixturePath(card, "Music & Audio", 16, 2, 79, 0, 0, 0)
+	card = setFixturePath(card, "com.spotify.music", 16, 11, 0, 0)
+	card = setFixturePath(card, "/store/apps/details?id=com.spotify.music", 17, 0, 0, 4, 2)
+	var ds any
+	ds = setFixturePath(ds, card, 0, 1, 0, 23)
+
+	got, err := extractFeatured(parsedData{"ds:1": ds}, "Spotify music and podcasts", "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil {
+		t.Fatal("expected a featured result")
+	}
+	if got.AppID != "com.spotify.music" || got.Title != "Spotify: Music and Podcasts" || got.Developer != "Spotify AB" {
+		t.Fatalf("unexpected featured app %+v", got.App)
+	}
+	if got.URL != BaseURL+"/store/apps/details?id=com.spotify.music" {
+		t.Fatalf("unexpected url %q", got.URL)
+	}
+	if got.Score == nil || *got.Score != 4.3 || got.Genre == nil || *got.Genre != "Music & Audio" {
+		t.Fatalf("unexpected score or genre %+v", got.App)
+	}
+	if got.MinInstalls == nil || *got.MinInstalls != 1000000000 {
+		t.Fatalf("unexpected minInstalls %v", got.MinInstalls)
+	}
+	if !got.Exact {
+		t.Fatal("expected exact hit")
+	}
+
+	got, err = extractFeatured(parsedData{"ds:1": setFixturePath(nil, "x", 0, 1, 0, 0)}, "spotify", "en")
+	if err != nil || got != nil {
+		t.Fatalf("expected no featured card, got %+v, %v", got, err)
+	}
+}
+
+func TestAssignPositionsAfterFeatured(t *testing.T) {
+	maps := []map[string]any{{"sponsored": true}, {}}
+	assignPositionsAfter(maps, 1)
+	first := maps[0]["position"].(map[string]any)
+	second := maps[1]["position"].(map[string]any)
+	if first["absolute"] != 2 || first["organic"] != 0 || second["absolute"] != 3 || second["organic"] != 2 {
+		t.Fatalf("unexpected positions %v %v", first, second)
+	}
+}
@@ -0,0 +1,158 @@
+package gplay
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Shelf struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	Apps  []App  `json:"apps"`
+}
+
+func (c *Client) Shelves(ctx context.Context, opts ShelvesOptions) ([]Shelf, error) {
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	country := opts.Country
+	if country == "" {
+		country = "us"
+	}
+	cacheOpts := opts
+	cacheOpts.Lang = lang
+	cacheOpts.Country = country
+	if c != nil && c.cache != nil {
+		var cached []Shelf
+		hit, err := c.cacheGet("shelves", cacheOpts, &cached)
+		if err != nil {
+			return nil, err
+		}
+		if hit {
+			return cached, nil
+		}
+	}
+
+	path := "/store/apps"
+	if opts.Category != "" {
+		path = "/store/apps/category/" + url.PathEscape(string(opts.Category))
+	}
+	qs := url.Values{}
+	qs.Set("hl", lang)
+	qs.Set("gl", country)
+	if opts.Age != nil {
+		qs.Set("age", string(*opts.Age))
+	}
+	body, _, err := c.do(ctx, requestOptions{URL: path + "?" + encodeValues(qs), Headers: opts.Headers}, opts.Throttle)
+	if err != nil {
+		return nil, err
+	}
+
+	shelves, err := extractShelves(parseScriptData(body), lang)
+	if err != nil {
+		return nil, err
+	}
+	c.cacheSet("shelves", cacheOpts, shelves)
+	return shelves, nil
+}
+
+func dsIndex(key string) int {
+	n, err := strconv.Atoi(strings.TrimPrefix(key, "ds:"))
+	if err != nil {
+		return math.MaxInt
+	}
+	return n
+}
+
+func extractShelves(parsed parsedData, lang string) ([]Shelf, error) {
+	keys := make([]string, 0, len(parsed))
+	for k := range parsed {
+		if strings.HasPrefix(k, "ds:") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return dsIndex(keys[i]) < dsIndex(keys[j]) })
+
+	out := make([]Shelf, 0)
+	seen := map[string]bool{}
+	for _, k := range keys {
+		var walkErr error
+		walkClusters(parsed[k], func(title, link string, items []any) {
+			if walkErr != nil {
+				return
+			}
+			appMaps := extractShelfItems(items, lang)
+			if len(appMaps) == 0 {
+				return
+			}
+			key := title + "\x00" + link
+			if seen[key] {
+				return
+			}
+			seen[key] = true
+			apps, err := appsFromMaps(appMaps)
+			if err != nil {
+				walkErr = err
+				return
+			}
+			var u string
+			if link != "" {
+				u = resolveURL(BaseURL, link)
+			}
+			out = append(out, Shelf{Title: title, URL: u, Apps: apps})
+		})
+		if walkErr != nil {
+			return nil, walkErr
+		}
+	}
+	return out, nil
+}
+
+func walkClusters(v any, fn func(title, link string, items []any)) {
+	arr, ok := v.([]any)
+	if !ok {
+		return
+	}
+	for _, idx := range []int{21, 22} {
+		if idx >= len(arr) {
+			continue
+		}
+		title, ok := asString(pathGet(arr[idx], []any{1, 0}))
+		items, isArr := pathGet(arr[idx], []any{0}).([]any)
+		if ok && isArr {
+			link, _ := asString(pathGet(arr[idx], []any{1, 2, 4, 2}))
+			fn(title, link, items)
+			return
+		}
+	}
+	for _, sub := range arr {
+		walkClusters(sub, fn)
+	}
+}
+
+func extractShelfItems(items []any, lang string) []map[string]any {
+	candidates := []map[string]fieldSpec{
+		prefixMappings(clusterItemMappings()),
+		prefixMappings(clusterItemMappings(0)),
+		prefixMappings(appListExtractor{lang: lang}.itemMappings()),
+	}
+	out := make([]map[string]any, 0, len(items))
+	for _, it := range items {
+		for _, m := range candidates {
+			fields := extractFields(parsedData{"root": it}, m)
+			if id, _ := fields["appId"].(string); id == "" {
+				continue
+			}
+			fillFromText(fields, lang)
+			out = append(out, fields)
+			break
+		}
+	}
+	assignPositions(out)
+	return out
+}
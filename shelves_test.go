@@ -0,0 +1,54 @@
+package gplay
+
+import "testing"
+
+func TestExtractShelves(t *testing.T) {
+	item := func(id, title string) any {
+		it := make([]any, 15)
+		it[0] = []any{id}
+		it[3] = title
+		return it
+	}
+	shelf := func(title, link string, items ...any) any {
+		node := make([]any, 22)
+		node[21] = []any{items, []any{title, nil, []any{nil, nil, nil, nil, []any{nil, nil, link}}}}
+		return node
+	}
+	pd := parsedData{
+		"ds:3": []any{[]any{
+			shelf("Recommended for you", "/store/apps/collection/cluster?gsr=a", item("com.a", "A"), item("com.b", "B")),
+			shelf("Empty", "/store/apps/collection/cluster?gsr=b"),
+			[]any{shelf("Nested", "", item("com.c", "C"))},
+		}},
+		"serviceRequestData": map[string]any{},
+	}
+
+	got, err := extractShelves(pd, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 shelves, got %d: %+v", len(got), got)
+	}
+	if got[0].Title != "Recommended for you" || len(got[0].Apps) != 2 || got[0].Apps[1].AppID != "com.b" {
+		t.Fatalf("unexpected first shelf %+v", got[0])
+	}
+	if got[0].URL != BaseURL+"/store/apps/collection/cluster?gsr=a" {
+		t.Fatalf("unexpected shelf url %q", got[0].URL)
+	}
+	if got[1].Title != "Nested" || got[1].URL != "" {
+		t.Fatalf("unexpected nested shelf %+v", got[1])
+	}
+
+	pd = parsedData{
+		"ds:10": []any{shelf("Tenth", "/store/apps/collection/cluster?gsr=10", item("com.j", "J"))},
+		"ds:2":  []any{shelf("Second", "/store/apps/collection/cluster?gsr=2", item("com.b", "B"))},
+	}
+	got, err = extractShelves(pd, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Title != "Second" || got[1].Title != "Tenth" {
+		t.Fatalf("expected shelves in data block order, got %+v", got)
+	}
+}
@@ -2,14 +2,14 @@ package gplay
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"net/url"
 )
 
+var ErrSimilarNotFound = errors.New("Similar apps not found")
+
 func (c *Client) Similar(ctx context.Context, opts SimilarOptions) ([]App, error) {
 	if opts.AppID == "" {
-		return nil, errors.New("appId missing")
+		return nil, optionError("appId missing")
 	}
 	lang := opts.Lang
 	if lang == "" {
@@ -38,96 +38,36 @@ func (c *Client) Similar(ctx context.Context, opts SimilarOptions) ([]App, error
 		}
 	}
 
-	qs := url.Values{}
-	qs.Set("id", opts.AppID)
-	qs.Set("hl", "en")
-	qs.Set("gl", country)
-	pageURL := "/store/apps/details?" + encodeValues(qs)
-	body, _, err := c.do(ctx, requestOptions{URL: pageURL, Headers: opts.Headers}, opts.Throttle)
+	clusters, err := c.detailsClusters(ctx, opts.CallOptions, opts.AppID, lang, country)
 	if err != nil {
 		return nil, err
 	}
-	parsed := parseScriptData(body)
-
-	clustersAny := extractDataWithServiceRequestID(parsed, serviceRequestSpec{Path: []any{1, 1}, UseServiceRequestID: "ag2B9c"})
-	clusters, _ := clustersAny.([]any)
-	if len(clusters) == 0 {
-		return nil, errors.New("Similar apps not found")
-	}
-
-	cluster := clusters[0]
+	clusterPath := ""
 	for _, cl := range clusters {
-		title, _ := asString(pathGet(cl, []any{21, 1, 0}))
-		if title == "Similar apps" || title == "Similar games" {
-			cluster = cl
+		if cl.Type == ClusterSimilar {
+			clusterPath = cl.URL
 			break
 		}
 	}
-
-	clusterPath, _ := asString(pathGet(cluster, []any{21, 1, 2, 4, 2}))
 	if clusterPath == "" {
-		return nil, errors.New("Similar apps not found")
+		return nil, ErrSimilarNotFound
 	}
 
-	clusterURL := BaseURL + clusterPath + "&gl=" + queryEscape(country) + "&hl=" + queryEscape(lang)
-	clusterBody, _, err := c.do(ctx, requestOptions{URL: clusterURL, Headers: opts.Headers}, opts.Throttle)
+	clusterURL, err := clusterPageURL(clusterPath, lang, country)
 	if err != nil {
 		return nil, err
 	}
-
-	clusterParsed := parseScriptData(clusterBody)
-
-	m := map[string]fieldSpec{
-		"title":     {Path: []any{3}},
-		"appId":     {Path: []any{0, 0}},
-		"url":       {Path: []any{10, 4, 2}, Fn: func(input any, _ parsedData) any { p, _ := asString(input); return resolveURL(BaseURL, p) }},
-		"icon":      {Path: []any{1, 3, 2}},
-		"developer": {Path: []any{14}},
-		"currency":  {Path: []any{8, 1, 0, 1}},
-		"price":     {Path: []any{8, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v / 1000000 }},
-		"free":      {Path: []any{8, 1, 0, 0}, Fn: func(input any, _ parsedData) any { v, _ := asFloat(input); return v == 0 }},
-		"summary":   {Path: []any{13, 1}},
-		"scoreText": {Path: []any{4, 0}},
-		"score":     {Path: []any{4, 1}},
-	}
-
-	appsAny := pathGet(clusterParsed, []any{"ds:3", 0, 1, 0, 21, 0})
-	appsArr, _ := appsAny.([]any)
-	appMaps := make([]map[string]any, 0, len(appsArr))
-	for _, it := range appsArr {
-		appMaps = append(appMaps, extractFields(parsedData{"root": it}, prefixMappings(m)))
-	}
-	token, _ := asString(pathGet(clusterParsed, []any{"ds:3", 0, 1, 0, 21, 1, 3, 1}))
-
-	page := pageMappings{Apps: []any{0, 0, 0}, Token: []any{0, 0, 7, 1}}
-	more, err := checkFinished(ctx, c, opts.CallOptions, lang, country, num, appMaps, token, page)
+	more, err := c.fetchCluster(ctx, opts.CallOptions, clusterURL, lang, country, num)
 	if err != nil {
 		return nil, err
 	}
-
-	apps := make([]App, 0, len(more))
-	for _, mm := range more {
-		b, err := json.Marshal(mm)
-		if err != nil {
-			return nil, err
-		}
-		var a App
-		if err := json.Unmarshal(b, &a); err != nil {
-			return nil, err
-		}
-		apps = append(apps, a)
+	apps, err := appsFromMaps(more)
+	if err != nil {
+		return nil, err
 	}
 
 	if opts.FullDetail {
-		out := make([]App, 0, len(apps))
-		for _, a := range apps {
-			full, err := c.App(ctx, AppOptions{CallOptions: opts.CallOptions, AppID: a.AppID, Lang: lang, Country: country})
-			if err != nil {
-				return nil, err
-			}
-			out = append(out, full)
-		}
-		return out, nil
+		return c.fullDetailApps(ctx, opts.CallOptions, apps, lang, country)
 	}
 	c.cacheSet("similar", cacheOpts, apps)
 	return apps, nil
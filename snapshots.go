@@ -0,0 +1,387 @@
+package gplay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type AppSnapshot struct {
+	AppID       string             `json:"appId"`
+	Lang        string             `json:"lang"`
+	Country     string             `json:"country"`
+	TakenAt     time.Time          `json:"takenAt"`
+	App         App                `json:"app"`
+	Permissions *PermissionsResult `json:"permissions,omitempty"`
+	DataSafety  *DataSafetyResult  `json:"dataSafety,omitempty"`
+}
+
+func (s AppSnapshot) Key() SnapshotKey {
+	return SnapshotKey{AppID: s.AppID, Lang: s.Lang, Country: s.Country}
+}
+
+type SnapshotKey struct {
+	AppID   string `json:"appId"`
+	Lang    string `json:"lang"`
+	Country string `json:"country"`
+}
+
+type SnapshotStore interface {
+	SaveSnapshot(ctx context.Context, snap AppSnapshot) error
+	LatestSnapshot(ctx context.Context, key SnapshotKey) (*AppSnapshot, error)
+	Snapshots(ctx context.Context, key SnapshotKey) ([]AppSnapshot, error)
+}
+
+type MemorySnapshotStore struct {
+	mu        sync.Mutex
+	snapshots map[SnapshotKey][]AppSnapshot
+}
+
+func (s *MemorySnapshotStore) SaveSnapshot(_ context.Context, snap AppSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.snapshots == nil {
+		s.snapshots = map[SnapshotKey][]AppSnapshot{}
+	}
+	s.snapshots[snap.Key()] = append(s.snapshots[snap.Key()], snap)
+	return nil
+}
+
+func (s *MemorySnapshotStore) LatestSnapshot(_ context.Context, key SnapshotKey) (*AppSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snaps := s.snapshots[key]
+	if len(snaps) == 0 {
+		return nil, nil
+	}
+	snap := snaps[len(snaps)-1]
+	return &snap, nil
+}
+
+func (s *MemorySnapshotStore) Snapshots(_ context.Context, key SnapshotKey) ([]AppSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]AppSnapshot{}, s.snapshots[key]...), nil
+}
+
+type FileSnapshotStore struct {
+	Dir string
+}
+
+const snapshotFileLayout = "20060102T150405.000000000Z"
+
+func (s *FileSnapshotStore) keyDir(key SnapshotKey) (string, error) {
+	for _, part := range []string{key.AppID, key.Lang, key.Country} {
+		if part == "" || strings.ContainsAny(part, `/\`) || part == "." || part == ".." {
+			return "", fmt.Errorf("invalid snapshot key %s/%s_%s", key.AppID, key.Lang, key.Country)
+		}
+	}
+	return filepath.Join(s.Dir, key.AppID, key.Lang+"_"+key.Country), nil
+}
+
+func (s *FileSnapshotStore) SaveSnapshot(_ context.Context, snap AppSnapshot) error {
+	dir, err := s.keyDir(snap.Key())
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(dir, snap.TakenAt.UTC().Format(snapshotFileLayout)+".json")
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+func (s *FileSnapshotStore) files(key SnapshotKey) ([]string, error) {
+	dir, err := s.keyDir(key)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			out = append(out, filepath.Join(dir, e.Name()))
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func readSnapshotFile(name string) (AppSnapshot, error) {
+	var snap AppSnapshot
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return snap, err
+	}
+	if err := json.Unmarshal(b, &snap); err != nil {
+		return snap, fmt.Errorf("%s: %w", name, err)
+	}
+	return snap, nil
+}
+
+func (s *FileSnapshotStore) LatestSnapshot(_ context.Context, key SnapshotKey) (*AppSnapshot, error) {
+	files, err := s.files(key)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	snap, err := readSnapshotFile(files[len(files)-1])
+	if err != nil {
+		return nil, err
+	}
+	return &snap, nil
+}
+
+func (s *FileSnapshotStore) Snapshots(_ context.Context, key SnapshotKey) ([]AppSnapshot, error) {
+	files, err := s.files(key)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]AppSnapshot, 0, len(files))
+	for _, f := range files {
+		snap, err := readSnapshotFile(f)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, snap)
+	}
+	return out, nil
+}
+
+type SnapshotResult struct {
+	Snapshot AppSnapshot   `json:"snapshot"`
+	Previous *AppSnapshot  `json:"previous"`
+	Changes  []ChangeEvent `json:"changes"`
+}
+
+func (c *Client) Snapshot(ctx context.Context, opts SnapshotOptions) (SnapshotResult, error) {
+	if opts.AppID == "" {
+		return SnapshotResult{}, optionError("appId missing")
+	}
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	country := opts.Country
+	if country == "" {
+		country = "us"
+	}
+	store := opts.Store
+	if store == nil {
+		store = &FileSnapshotStore{Dir: "snapshots"}
+	}
+
+	app, err := c.App(ctx, AppOptions{CallOptions: opts.CallOptions, AppID: opts.AppID, Lang: lang, Country: country})
+	if err != nil {
+		return SnapshotResult{}, err
+	}
+	snap := AppSnapshot{AppID: opts.AppID, Lang: lang, Country: country, App: app}
+	if opts.Permissions {
+		perms, err := c.Permissions(ctx, PermissionsOptions{CallOptions: opts.CallOptions, AppID: opts.AppID, Lang: lang, Country: country})
+		if err != nil {
+			return SnapshotResult{}, err
+		}
+		snap.Permissions = &perms
+	}
+	if opts.DataSafety {
+		ds, err := c.DataSafety(ctx, DataSafetyOptions{CallOptions: opts.CallOptions, AppID: opts.AppID, Lang: lang})
+		if err != nil {
+			return SnapshotResult{}, err
+		}
+		snap.DataSafety = &ds
+	}
+	snap.TakenAt = time.Now().UTC()
+	return recordSnapshot(ctx, store, snap)
+}
+
+func recordSnapshot(ctx context.Context, store SnapshotStore, snap AppSnapshot) (SnapshotResult, error) {
+	prev, err := store.LatestSnapshot(ctx, snap.Key())
+	if err != nil {
+		return SnapshotResult{}, err
+	}
+	if err := store.SaveSnapshot(ctx, snap); err != nil {
+		return SnapshotResult{}, err
+	}
+	res := SnapshotResult{Snapshot: snap, Previous: prev, Changes: make([]ChangeEvent, 0)}
+	if prev != nil {
+		res.Changes = DiffSnapshots(*prev, snap)
+	}
+	return res, nil
+}
+
+type ChangeType string
+
+const (
+	ChangeVersion              ChangeType = "version_changed"
+	ChangeUpdated              ChangeType = "updated"
+	ChangePriceDropped         ChangeType = "price_dropped"
+	ChangePriceRaised          ChangeType = "price_raised"
+	ChangeCurrency             ChangeType = "currency_changed"
+	ChangeScore                ChangeType = "score_changed"
+	ChangeInstalls             ChangeType = "installs_changed"
+	ChangeAvailability         ChangeType = "availability_changed"
+	ChangePermissionAdded      ChangeType = "permission_added"
+	ChangePermissionRemoved    ChangeType = "permission_removed"
+	ChangeDataSharedAdded      ChangeType = "data_shared_added"
+	ChangeDataSharedRemoved    ChangeType = "data_shared_removed"
+	ChangeDataCollectedAdded   ChangeType = "data_collected_added"
+	ChangeDataCollectedRemoved ChangeType = "data_collected_removed"
+)
+
+type ChangeEvent struct {
+	Type    ChangeType `json:"type"`
+	AppID   string     `json:"appId"`
+	Lang    string     `json:"lang,omitempty"`
+	Country string     `json:"country,omitempty"`
+	Field   string     `json:"field"`
+	Old     any        `json:"old"`
+	New     any        `json:"new"`
+	At      time.Time  `json:"at"`
+}
+
+func DiffSnapshots(prev, cur AppSnapshot) []ChangeEvent {
+	out := make([]ChangeEvent, 0)
+	add := func(typ ChangeType, field string, old, new any) {
+		out = append(out, ChangeEvent{Type: typ, AppID: cur.AppID, Lang: cur.Lang, Country: cur.Country, Field: field, Old: old, New: new, At: cur.TakenAt})
+	}
+	a, b := prev.App, cur.App
+
+	if ov, nv := strOr(a.Version), strOr(b.Version); ov != nv {
+		add(ChangeVersion, "version", ov, nv)
+	}
+	if a.Updated != nil && b.Updated != nil && *a.Updated != *b.Updated {
+		add(ChangeUpdated, "updated", *a.Updated, *b.Updated)
+	}
+	switch oc, nc := strOr(a.Currency), strOr(b.Currency); {
+	case oc == nc:
+		if op, np := priceOf(a), priceOf(b); op != nil && np != nil && *op != *np {
+			typ := ChangePriceRaised
+			if *np < *op {
+				typ = ChangePriceDropped
+			}
+			add(typ, "price", *op, *np)
+		}
+	case oc != "" && nc != "":
+		add(ChangeCurrency, "currency", oc, nc)
+	}
+	if a.Score != nil && b.Score != nil && scoreKey(*a.Score) != scoreKey(*b.Score) {
+		add(ChangeScore, "score", *a.Score, *b.Score)
+	}
+	if a.MinInstalls != nil && b.MinInstalls != nil && *a.MinInstalls != *b.MinInstalls {
+		add(ChangeInstalls, "minInstalls", *a.MinInstalls, *b.MinInstalls)
+	}
+	if a.Available != nil && b.Available != nil && *a.Available != *b.Available {
+		add(ChangeAvailability, "available", *a.Available, *b.Available)
+	}
+
+	if prev.Permissions != nil && cur.Permissions != nil {
+		added, removed := diffKeys(permissionKeys(*prev.Permissions), permissionKeys(*cur.Permissions))
+		for _, k := range added {
+			add(ChangePermissionAdded, "permissions", nil, k)
+		}
+		for _, k := range removed {
+			add(ChangePermissionRemoved, "permissions", k, nil)
+		}
+	}
+	if prev.DataSafety != nil && cur.DataSafety != nil {
+		added, removed := diffKeys(dataSafetyKeys(prev.DataSafety.SharedData), dataSafetyKeys(cur.DataSafety.SharedData))
+		for _, k := range added {
+			add(ChangeDataSharedAdded, "dataShared", nil, k)
+		}
+		for _, k := range removed {
+			add(ChangeDataSharedRemoved, "dataShared", k, nil)
+		}
+		added, removed = diffKeys(dataSafetyKeys(prev.DataSafety.CollectedData), dataSafetyKeys(cur.DataSafety.CollectedData))
+		for _, k := range added {
+			add(ChangeDataCollectedAdded, "dataCollected", nil, k)
+		}
+		for _, k := range removed {
+			add(ChangeDataCollectedRemoved, "dataCollected", k, nil)
+		}
+	}
+	return out
+}
+
+func strOr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func priceOf(a App) *int64 {
+	if a.PriceMicros != nil {
+		return a.PriceMicros
+	}
+	if a.Price != nil {
+		v := int64(math.Round(*a.Price * microsPerUnit))
+		return &v
+	}
+	return nil
+}
+
+func scoreKey(v float64) int64 {
+	return int64(v*10 + 0.5)
+}
+
+func permissionKeys(p PermissionsResult) []string {
+	out := make([]string, 0, len(p.Items)+len(p.Names))
+	for _, it := range p.Items {
+		out = append(out, it.Type+": "+it.Permission)
+	}
+	if len(p.Items) == 0 {
+		out = append(out, p.Names...)
+	}
+	return out
+}
+
+func dataSafetyKeys(entries []DataSafetyEntry) []string {
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e.Type+": "+e.Data)
+	}
+	return out
+}
+
+func diffKeys(old, new []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(old))
+	for _, k := range old {
+		oldSet[k] = true
+	}
+	newSet := make(map[string]bool, len(new))
+	for _, k := range new {
+		newSet[k] = true
+		if !oldSet[k] {
+			added = append(added, k)
+			oldSet[k] = true
+		}
+	}
+	for _, k := range old {
+		if !newSet[k] {
+			removed = append(removed, k)
+			newSet[k] = true
+		}
+	}
+	return added, removed
+}
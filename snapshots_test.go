@@ -0,0 +1,148 @@
+package gplay
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	str := func(s string) *string { return &s }
+	i64 := func(v int64) *int64 { return &v }
+	f64 := func(v float64) *float64 { return &v }
+	prev := AppSnapshot{
+		AppID: "com.a",
+		App:   App{Version: str("1.0"), PriceMicros: i64(4990000), Score: f64(4.31), MinInstalls: i64(1000)},
+		Permissions: &PermissionsResult{Items: []PermissionItem{
+			{Type: "Location", Permission: "approximate location"},
+			{Type: "Camera", Permission: "take pictures"},
+		}},
+		DataSafety: &DataSafetyResult{SharedData: []DataSafetyEntry{{Type: "Location", Data: "Approximate location"}}},
+	}
+	cur := AppSnapshot{
+		AppID:   "com.a",
+		TakenAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		App:     App{Version: str("1.1"), PriceMicros: i64(1990000), Score: f64(4.33), MinInstalls: i64(5000)},
+		Permissions: &PermissionsResult{Items: []PermissionItem{
+			{Type: "Location", Permission: "approximate location"},
+			{Type: "Microphone", Permission: "record audio"},
+		}},
+		DataSafety: &DataSafetyResult{},
+	}
+
+	got := map[ChangeType]ChangeEvent{}
+	for _, ev := range DiffSnapshots(prev, cur) {
+		got[ev.Type] = ev
+	}
+	if len(got) != 6 {
+		t.Fatalf("unexpected changes %+v", got)
+	}
+	if ev := got[ChangeVersion]; ev.Old != "1.0" || ev.New != "1.1" || !ev.At.Equal(cur.TakenAt) {
+		t.Fatalf("unexpected version change %+v", ev)
+	}
+	if ev := got[ChangePriceDropped]; ev.Old != int64(4990000) || ev.New != int64(1990000) {
+		t.Fatalf("unexpected price change %+v", ev)
+	}
+	if got[ChangePermissionAdded].New != "Microphone: record audio" || got[ChangePermissionRemoved].Old != "Camera: take pictures" {
+		t.Fatalf("unexpected permission changes %+v", got)
+	}
+	if _, ok := got[ChangeScore]; ok {
+		t.Fatalf("score change below display precision should be ignored")
+	}
+	if _, ok := got[ChangeInstalls]; !ok {
+		t.Fatalf("expected installs change")
+	}
+	if _, ok := got[ChangeDataSharedRemoved]; !ok {
+		t.Fatalf("expected data shared change")
+	}
+}
+
+func TestFileSnapshotStore(t *testing.T) {
+	ctx := context.Background()
+	store := &FileSnapshotStore{Dir: t.TempDir()}
+	key := SnapshotKey{AppID: "com.a", Lang: "en", Country: "us"}
+	if snap, err := store.LatestSnapshot(ctx, key); err != nil || snap != nil {
+		t.Fatalf("expected no snapshot, got %v %v", snap, err)
+	}
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, title := range []string{"One", "Two"} {
+		if err := store.SaveSnapshot(ctx, AppSnapshot{AppID: "com.a", Lang: "en", Country: "us", TakenAt: day1.Add(time.Duration(i) * time.Hour), App: App{Title: title}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.SaveSnapshot(ctx, AppSnapshot{AppID: "com.a", Lang: "de", Country: "de", TakenAt: day1.Add(2 * time.Hour), App: App{Title: "Drei"}}); err != nil {
+		t.Fatal(err)
+	}
+	latest, err := store.LatestSnapshot(ctx, key)
+	if err != nil || latest.App.Title != "Two" {
+		t.Fatalf("unexpected latest snapshot %+v %v", latest, err)
+	}
+	all, err := store.Snapshots(ctx, key)
+	if err != nil || len(all) != 2 {
+		t.Fatalf("unexpected snapshots %d %v", len(all), err)
+	}
+	if err := store.SaveSnapshot(ctx, AppSnapshot{AppID: "../x", Lang: "en", Country: "us"}); err == nil {
+		t.Fatalf("expected invalid appId error")
+	}
+	if err := store.SaveSnapshot(ctx, AppSnapshot{AppID: "com.a", Lang: "en", Country: "../us"}); err == nil {
+		t.Fatalf("expected invalid country error")
+	}
+}
+
+func TestRecordSnapshotPerMarket(t *testing.T) {
+	ctx := context.Background()
+	str := func(s string) *string { return &s }
+	for _, store := range []SnapshotStore{&MemorySnapshotStore{}, &FileSnapshotStore{Dir: t.TempDir()}} {
+		day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		us := AppSnapshot{AppID: "com.a", Lang: "en", Country: "us", TakenAt: day1, App: App{Version: str("1.0")}}
+		de := AppSnapshot{AppID: "com.a", Lang: "en", Country: "de", TakenAt: day1.Add(time.Minute), App: App{Version: str("0.9")}}
+		for round := 0; round < 2; round++ {
+			for _, snap := range []AppSnapshot{us, de} {
+				snap.TakenAt = snap.TakenAt.Add(time.Duration(round) * time.Hour)
+				res, err := recordSnapshot(ctx, store, snap)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(res.Changes) != 0 {
+					t.Fatalf("%T %s round %d: unexpected changes %+v", store, snap.Country, round, res.Changes)
+				}
+				if (res.Previous != nil) != (round == 1) || (res.Previous != nil && res.Previous.Country != snap.Country) {
+					t.Fatalf("%T %s round %d: unexpected previous %+v", store, snap.Country, round, res.Previous)
+				}
+			}
+		}
+	}
+}
+
+func TestDiffSnapshotsCurrency(t *testing.T) {
+	str := func(s string) *string { return &s }
+	i64 := func(v int64) *int64 { return &v }
+	snap := func(currency *string, micros int64) AppSnapshot {
+		return AppSnapshot{AppID: "com.a", App: App{Currency: currency, PriceMicros: i64(micros)}}
+	}
+	cases := []struct {
+		name string
+		prev AppSnapshot
+		cur  AppSnapshot
+		want []ChangeType
+	}{
+		{"same currency raised", snap(str("USD"), 990000), snap(str("USD"), 1990000), []ChangeType{ChangePriceRaised}},
+		{"same currency dropped", snap(str("EUR"), 1990000), snap(str("EUR"), 990000), []ChangeType{ChangePriceDropped}},
+		{"currency changed", snap(str("USD"), 990000), snap(str("EUR"), 1090000), []ChangeType{ChangeCurrency}},
+		{"currency unknown", snap(nil, 990000), snap(str("EUR"), 1090000), nil},
+		{"unchanged", snap(str("USD"), 990000), snap(str("USD"), 990000), nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := DiffSnapshots(tc.prev, tc.cur)
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %+v, want %v", got, tc.want)
+			}
+			for i, typ := range tc.want {
+				if got[i].Type != typ {
+					t.Fatalf("got %+v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
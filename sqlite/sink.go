@@ -0,0 +1,445 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+	_ "modernc.org/sqlite"
+)
+
+var migrations = []string{
+	`CREATE TABLE apps (
+		app_id TEXT PRIMARY KEY,
+		url TEXT,
+		title TEXT,
+		summary TEXT,
+		developer TEXT,
+		developer_id TEXT,
+		icon TEXT,
+		score REAL,
+		score_text TEXT,
+		price_text TEXT,
+		free INTEGER,
+		currency TEXT,
+		price REAL,
+		price_micros INTEGER,
+		original_price REAL,
+		original_price_micros INTEGER,
+		discount_end_date TEXT,
+		description TEXT,
+		description_html TEXT,
+		installs TEXT,
+		min_installs INTEGER,
+		max_installs INTEGER,
+		ratings INTEGER,
+		reviews INTEGER,
+		histogram TEXT,
+		available INTEGER,
+		offers_iap INTEGER,
+		iap_range TEXT,
+		size TEXT,
+		android_version TEXT,
+		android_version_text TEXT,
+		android_max_version TEXT,
+		developer_internal_id TEXT,
+		developer_email TEXT,
+		developer_website TEXT,
+		developer_address TEXT,
+		developer_legal_name TEXT,
+		developer_legal_email TEXT,
+		developer_legal_address TEXT,
+		developer_legal_phone_number TEXT,
+		privacy_policy TEXT,
+		genre TEXT,
+		genre_id TEXT,
+		header_image TEXT,
+		video TEXT,
+		video_image TEXT,
+		preview_video TEXT,
+		content_rating TEXT,
+		content_rating_description TEXT,
+		ad_supported INTEGER,
+		released TEXT,
+		updated INTEGER,
+		version TEXT,
+		recent_changes TEXT,
+		preregister INTEGER,
+		early_access_enabled INTEGER,
+		is_available_in_play_pass INTEGER,
+		scraped_at TEXT NOT NULL
+	);
+	CREATE TABLE app_categories (
+		app_id TEXT NOT NULL REFERENCES apps(app_id) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		category_id TEXT,
+		PRIMARY KEY (app_id, position)
+	);
+	CREATE TABLE screenshots (
+		app_id TEXT NOT NULL REFERENCES apps(app_id) ON DELETE CASCADE,
+		position INTEGER NOT NULL,
+		url TEXT NOT NULL,
+		PRIMARY KEY (app_id, position)
+	);
+	CREATE TABLE reviews (
+		review_id TEXT PRIMARY KEY,
+		app_id TEXT NOT NULL,
+		user_name TEXT,
+		user_image TEXT,
+		date TEXT,
+		score INTEGER,
+		score_text TEXT,
+		url TEXT,
+		title TEXT,
+		text TEXT,
+		reply_date TEXT,
+		reply_text TEXT,
+		version TEXT,
+		thumbs_up INTEGER,
+		scraped_at TEXT NOT NULL
+	);
+	CREATE INDEX reviews_app_id ON reviews(app_id);
+	CREATE TABLE review_criterias (
+		review_id TEXT NOT NULL REFERENCES reviews(review_id) ON DELETE CASCADE,
+		criteria TEXT NOT NULL,
+		rating INTEGER,
+		PRIMARY KEY (review_id, criteria)
+	);
+	CREATE TABLE permissions (
+		app_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		permission TEXT NOT NULL,
+		PRIMARY KEY (app_id, type, permission)
+	);
+	CREATE TABLE data_safety (
+		app_id TEXT NOT NULL,
+		section TEXT NOT NULL,
+		type TEXT NOT NULL,
+		data TEXT NOT NULL,
+		purpose TEXT NOT NULL,
+		optional INTEGER NOT NULL,
+		PRIMARY KEY (app_id, section, type, data, purpose)
+	);
+	CREATE TABLE security_practices (
+		app_id TEXT NOT NULL,
+		practice TEXT NOT NULL,
+		description TEXT,
+		PRIMARY KEY (app_id, practice)
+	);`,
+}
+
+type column[T any] struct {
+	name  string
+	value func(T) any
+}
+
+var appColumns = []column[gplay.App]{
+	{"app_id", func(a gplay.App) any { return a.AppID }},
+	{"url", func(a gplay.App) any { return a.URL }},
+	{"title", func(a gplay.App) any { return a.Title }},
+	{"summary", func(a gplay.App) any { return a.Summary }},
+	{"developer", func(a gplay.App) any { return a.Developer }},
+	{"developer_id", func(a gplay.App) any { return a.DeveloperID }},
+	{"icon", func(a gplay.App) any { return a.Icon }},
+	{"score", func(a gplay.App) any { return deref(a.Score) }},
+	{"score_text", func(a gplay.App) any { return deref(a.ScoreText) }},
+	{"price_text", func(a gplay.App) any { return deref(a.PriceText) }},
+	{"free", func(a gplay.App) any { return deref(a.Free) }},
+	{"currency", func(a gplay.App) any { return deref(a.Currency) }},
+	{"price", func(a gplay.App) any { return deref(a.Price) }},
+	{"price_micros", func(a gplay.App) any { return deref(a.PriceMicros) }},
+	{"original_price", func(a gplay.App) any { return deref(a.OriginalPrice) }},
+	{"original_price_micros", func(a gplay.App) any { return deref(a.OriginalPriceMicros) }},
+	{"discount_end_date", func(a gplay.App) any { return deref(a.DiscountEndDate) }},
+	{"description", func(a gplay.App) any { return deref(a.Description) }},
+	{"description_html", func(a gplay.App) any { return deref(a.DescriptionHTML) }},
+	{"installs", func(a gplay.App) any { return deref(a.Installs) }},
+	{"min_installs", func(a gplay.App) any { return deref(a.MinInstalls) }},
+	{"max_installs", func(a gplay.App) any { return deref(a.MaxInstalls) }},
+	{"ratings", func(a gplay.App) any { return deref(a.Ratings) }},
+	{"reviews", func(a gplay.App) any { return deref(a.Reviews) }},
+	{"histogram", func(a gplay.App) any { return jsonOrNil(a.Histogram) }},
+	{"available", func(a gplay.App) any { return deref(a.Available) }},
+	{"offers_iap", func(a gplay.App) any { return deref(a.OffersIAP) }},
+	{"iap_range", func(a gplay.App) any { return deref(a.IAPRange) }},
+	{"size", func(a gplay.App) any { return deref(a.Size) }},
+	{"android_version", func(a gplay.App) any { return deref(a.AndroidVersion) }},
+	{"android_version_text", func(a gplay.App) any { return deref(a.AndroidVersionText) }},
+	{"android_max_version", func(a gplay.App) any { return deref(a.AndroidMaxVersion) }},
+	{"developer_internal_id", func(a gplay.App) any { return deref(a.DeveloperInternalID) }},
+	{"developer_email", func(a gplay.App) any { return deref(a.DeveloperEmail) }},
+	{"developer_website", func(a gplay.App) any { return deref(a.DeveloperWebsite) }},
+	{"developer_address", func(a gplay.App) any { return deref(a.DeveloperAddress) }},
+	{"developer_legal_name", func(a gplay.App) any { return deref(a.DeveloperLegalName) }},
+	{"developer_legal_email", func(a gplay.App) any { return deref(a.DeveloperLegalEmail) }},
+	{"developer_legal_address", func(a gplay.App) any { return deref(a.DeveloperLegalAddress) }},
+	{"developer_legal_phone_number", func(a gplay.App) any { return deref(a.DeveloperLegalPhoneNumber) }},
+	{"privacy_policy", func(a gplay.App) any { return deref(a.PrivacyPolicy) }},
+	{"genre", func(a gplay.App) any { return deref(a.Genre) }},
+	{"genre_id", func(a gplay.App) any { return deref(a.GenreID) }},
+	{"header_image", func(a gplay.App) any { return deref(a.HeaderImage) }},
+	{"video", func(a gplay.App) any { return deref(a.Video) }},
+	{"video_image", func(a gplay.App) any { return deref(a.VideoImage) }},
+	{"preview_video", func(a gplay.App) any { return deref(a.PreviewVideo) }},
+	{"content_rating", func(a gplay.App) any { return deref(a.ContentRating) }},
+	{"content_rating_description", func(a gplay.App) any { return deref(a.ContentRatingDescription) }},
+	{"ad_supported", func(a gplay.App) any { return deref(a.AdSupported) }},
+	{"released", func(a gplay.App) any { return deref(a.Released) }},
+	{"updated", func(a gplay.App) any { return deref(a.Updated) }},
+	{"version", func(a gplay.App) any { return deref(a.Version) }},
+	{"recent_changes", func(a gplay.App) any { return deref(a.RecentChanges) }},
+	{"preregister", func(a gplay.App) any { return deref(a.Preregister) }},
+	{"early_access_enabled", func(a gplay.App) any { return deref(a.EarlyAccessEnabled) }},
+	{"is_available_in_play_pass", func(a gplay.App) any { return deref(a.IsAvailableInPlayPass) }},
+}
+
+var reviewColumns = []column[gplay.Review]{
+	{"review_id", func(r gplay.Review) any { return r.ID }},
+	{"user_name", func(r gplay.Review) any { return r.UserName }},
+	{"user_image", func(r gplay.Review) any { return r.UserImage }},
+	{"date", func(r gplay.Review) any { return r.Date }},
+	{"score", func(r gplay.Review) any { return r.Score }},
+	{"score_text", func(r gplay.Review) any { return r.ScoreText }},
+	{"url", func(r gplay.Review) any { return r.URL }},
+	{"title", func(r gplay.Review) any { return deref(r.Title) }},
+	{"text", func(r gplay.Review) any { return r.Text }},
+	{"reply_date", func(r gplay.Review) any { return deref(r.ReplyDate) }},
+	{"reply_text", func(r gplay.Review) any { return deref(r.ReplyText) }},
+	{"version", func(r gplay.Review) any { return deref(r.Version) }},
+	{"thumbs_up", func(r gplay.Review) any { return deref(r.ThumbsUp) }},
+}
+
+var (
+	upsertAppSQL    = upsertSQL("apps", "app_id", columnNames(appColumns), "scraped_at")
+	upsertReviewSQL = upsertSQL("reviews", "review_id", columnNames(reviewColumns), "app_id", "scraped_at")
+)
+
+type Sink struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+func Open(path string) (*Sink, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	s, err := New(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func New(db *sql.DB) (*Sink, error) {
+	s := &Sink{db: db, now: time.Now}
+	if _, err := db.Exec(`PRAGMA foreign_keys = ON; PRAGMA busy_timeout = 5000`); err != nil {
+		return nil, err
+	}
+	if err := s.migrate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Sink) Close() error {
+	return s.db.Close()
+}
+
+func (s *Sink) DB() *sql.DB {
+	return s.db
+}
+
+func (s *Sink) SchemaVersion(ctx context.Context) (int, error) {
+	var v sql.NullInt64
+	err := s.db.QueryRowContext(ctx, `SELECT MAX(version) FROM schema_version`).Scan(&v)
+	return int(v.Int64), err
+}
+
+func (s *Sink) migrate() error {
+	if _, err := s.db.Exec(`CREATE TABLE IF NOT EXISTS schema_version (version INTEGER PRIMARY KEY, applied_at TEXT NOT NULL)`); err != nil {
+		return err
+	}
+	current, err := s.SchemaVersion(context.Background())
+	if err != nil {
+		return err
+	}
+	for i := current; i < len(migrations); i++ {
+		tx, err := s.db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(migrations[i]); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if _, err := tx.Exec(`INSERT INTO schema_version (version, applied_at) VALUES (?, ?)`, i+1, s.timestamp()); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Sink) WriteApp(ctx context.Context, app gplay.App) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		args := columnValues(appColumns, app)
+		if _, err := tx.ExecContext(ctx, upsertAppSQL, append(args, s.timestamp())...); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM app_categories WHERE app_id = ?`, app.AppID); err != nil {
+			return err
+		}
+		for i, c := range app.Categories {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO app_categories (app_id, position, name, category_id) VALUES (?, ?, ?, ?)`, app.AppID, i, c.Name, deref(c.ID)); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM screenshots WHERE app_id = ?`, app.AppID); err != nil {
+			return err
+		}
+		for i, u := range app.Screenshots {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO screenshots (app_id, position, url) VALUES (?, ?, ?)`, app.AppID, i, u); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Sink) WriteReviews(ctx context.Context, appID string, reviews []gplay.Review) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		ts := s.timestamp()
+		for _, r := range reviews {
+			args := append(columnValues(reviewColumns, r), appID, ts)
+			if _, err := tx.ExecContext(ctx, upsertReviewSQL, args...); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM review_criterias WHERE review_id = ?`, r.ID); err != nil {
+				return err
+			}
+			for _, c := range r.Criterias {
+				if _, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO review_criterias (review_id, criteria, rating) VALUES (?, ?, ?)`, r.ID, c.Criteria, deref(c.Rating)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Sink) WritePermissions(ctx context.Context, appID string, res gplay.PermissionsResult) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM permissions WHERE app_id = ?`, appID); err != nil {
+			return err
+		}
+		items := res.Items
+		if len(items) == 0 {
+			for _, n := range res.Names {
+				items = append(items, gplay.PermissionItem{Permission: n})
+			}
+		}
+		for _, p := range items {
+			if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO permissions (app_id, type, permission) VALUES (?, ?, ?)`, appID, p.Type, p.Permission); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Sink) WriteDataSafety(ctx context.Context, appID string, res gplay.DataSafetyResult) error {
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		for _, q := range []string{`DELETE FROM data_safety WHERE app_id = ?`, `DELETE FROM security_practices WHERE app_id = ?`} {
+			if _, err := tx.ExecContext(ctx, q, appID); err != nil {
+				return err
+			}
+		}
+		sections := []struct {
+			name    string
+			entries []gplay.DataSafetyEntry
+		}{{"shared", res.SharedData}, {"collected", res.CollectedData}}
+		for _, sec := range sections {
+			for _, e := range sec.entries {
+				if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO data_safety (app_id, section, type, data, purpose, optional) VALUES (?, ?, ?, ?, ?, ?)`, appID, sec.name, e.Type, e.Data, e.Purpose, e.Optional); err != nil {
+					return err
+				}
+			}
+		}
+		for _, p := range res.SecurityPractices {
+			if _, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO security_practices (app_id, practice, description) VALUES (?, ?, ?)`, appID, p.Practice, p.Description); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Sink) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *Sink) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+func columnNames[T any](cols []column[T]) []string {
+	out := make([]string, 0, len(cols))
+	for _, c := range cols {
+		out = append(out, c.name)
+	}
+	return out
+}
+
+func columnValues[T any](cols []column[T], v T) []any {
+	out := make([]any, 0, len(cols)+2)
+	for _, c := range cols {
+		out = append(out, c.value(v))
+	}
+	return out
+}
+
+func upsertSQL(table, key string, cols []string, extra ...string) string {
+	all := append(append([]string{}, cols...), extra...)
+	updates := make([]string, 0, len(all))
+	for _, c := range all {
+		if c != key {
+			updates = append(updates, c+" = excluded."+c)
+		}
+	}
+	return "INSERT INTO " + table + " (" + strings.Join(all, ", ") + ") VALUES (" +
+		strings.TrimSuffix(strings.Repeat("?, ", len(all)), ", ") + ") ON CONFLICT(" + key + ") DO UPDATE SET " +
+		strings.Join(updates, ", ")
+}
+
+func deref[T any](p *T) any {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
+func jsonOrNil(v map[string]int64) any {
+	if v == nil {
+		return nil
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	gplay "github.com/facundoolano/google-play-scraper-go"
+)
+
+func TestSinkUpserts(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "gplay.db")
+	s, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if v, err := s.SchemaVersion(ctx); err != nil || v != len(migrations) {
+		t.Fatalf("unexpected schema version %d %v", v, err)
+	}
+
+	score := 4.1
+	catID := "TOOLS"
+	app := gplay.App{AppID: "com.a", Title: "A", Score: &score, Screenshots: []string{"s1", "s2"}, Categories: []gplay.AppCategory{{Name: "Tools", ID: &catID}}, Histogram: map[string]int64{"5": 3}}
+	if err := s.WriteApp(ctx, app); err != nil {
+		t.Fatal(err)
+	}
+	app.Title = "A2"
+	app.Screenshots = []string{"s3"}
+	if err := s.WriteApp(ctx, app); err != nil {
+		t.Fatal(err)
+	}
+
+	var title, histogram string
+	var shots int
+	if err := s.DB().QueryRow(`SELECT title, histogram FROM apps WHERE app_id = 'com.a'`).Scan(&title, &histogram); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DB().QueryRow(`SELECT COUNT(*) FROM screenshots WHERE app_id = 'com.a'`).Scan(&shots); err != nil {
+		t.Fatal(err)
+	}
+	if title != "A2" || histogram != `{"5":3}` || shots != 1 {
+		t.Fatalf("unexpected app row %q %q %d", title, histogram, shots)
+	}
+
+	rating := int64(4)
+	reviews := []gplay.Review{{ID: "r1", Text: "ok", Score: 4, Criterias: []gplay.ReviewCriteria{{Criteria: "vaf_games_simple", Rating: &rating}}}}
+	for i := 0; i < 2; i++ {
+		if err := s.WriteReviews(ctx, "com.a", reviews); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.WritePermissions(ctx, "com.a", gplay.PermissionsResult{Items: []gplay.PermissionItem{{Type: "Camera", Permission: "take pictures"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.WriteDataSafety(ctx, "com.a", gplay.DataSafetyResult{SharedData: []gplay.DataSafetyEntry{{Type: "Location", Data: "Approximate location", Purpose: "Analytics"}}}); err != nil {
+		t.Fatal(err)
+	}
+	for table, want := range map[string]int{"reviews": 1, "review_criterias": 1, "permissions": 1, "data_safety": 1, "app_categories": 1} {
+		var n int
+		if err := s.DB().QueryRow(`SELECT COUNT(*) FROM ` + table).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		if n != want {
+			t.Fatalf("expected %d rows in %s, got %d", want, table, n)
+		}
+	}
+	s.Close()
+
+	s, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := s.SchemaVersion(ctx); v != len(migrations) {
+		t.Fatalf("reopen changed schema version to %d", v)
+	}
+}
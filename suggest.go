@@ -3,16 +3,46 @@ package gplay
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 )
 
 var suggestURLTemplate = "/_/PlayStoreUi/data/batchexecute?rpcids=IJ4APc&f.sid=-697906427155521722&bl=boq_playuiserver_20190903.08_p0&hl=%s&gl=%s&authuser&soc-app=121&soc-platform=1&soc-device=1&_reqid=1065213"
 
+type SuggestionKind string
+
+const (
+	SuggestionQuery SuggestionKind = "query"
+	SuggestionApp   SuggestionKind = "app"
+)
+
+type Suggestion struct {
+	Term     string         `json:"term"`
+	Kind     SuggestionKind `json:"kind"`
+	AppID    string         `json:"appId,omitempty"`
+	Icon     string         `json:"icon,omitempty"`
+	Position int            `json:"position"`
+}
+
+var packageNameRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*(\.[a-zA-Z][a-zA-Z0-9_]*)+$`)
+
 func (c *Client) Suggest(ctx context.Context, opts SuggestOptions) ([]string, error) {
+	sugs, err := c.SuggestDetailed(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(sugs))
+	for _, s := range sugs {
+		out = append(out, s.Term)
+	}
+	return out, nil
+}
+
+func (c *Client) SuggestDetailed(ctx context.Context, opts SuggestOptions) ([]Suggestion, error) {
 	if opts.Term == "" {
-		return nil, errors.New("term missing")
+		return nil, optionError("term missing")
 	}
 	lang := opts.Lang
 	if lang == "" {
@@ -26,7 +56,7 @@ func (c *Client) Suggest(ctx context.Context, opts SuggestOptions) ([]string, er
 	cacheOpts.Lang = lang
 	cacheOpts.Country = country
 	if c != nil && c.cache != nil {
-		var cached []string
+		var cached []Suggestion
 		hit, err := c.cacheGet("suggest", cacheOpts, &cached)
 		if err != nil {
 			return nil, err
@@ -36,19 +66,28 @@ func (c *Client) Suggest(ctx context.Context, opts SuggestOptions) ([]string, er
 		}
 	}
 
+	items, err := c.suggestItems(ctx, opts.CallOptions, opts.Term, lang, country)
+	if err != nil {
+		return nil, err
+	}
+	out := extractSuggestions(items)
+	c.cacheSet("suggest", cacheOpts, out)
+	return out, nil
+}
+
+func (c *Client) suggestItems(ctx context.Context, callOpts CallOptions, term, lang, country string) ([]any, error) {
 	u := fmt.Sprintf(suggestURLTemplate, queryEscape(lang), queryEscape(country))
-	term := queryEscape(opts.Term)
-	body := fmt.Sprintf("f.req=%%5B%%5B%%5B%%22IJ4APc%%22%%2C%%22%%5B%%5Bnull%%2C%%5B%%5C%%22%s%%5C%%22%%5D%%2C%%5B10%%5D%%2C%%5B2%%5D%%2C4%%5D%%5D%%22%%5D%%5D%%5D", term)
+	body := fmt.Sprintf("f.req=%%5B%%5B%%5B%%22IJ4APc%%22%%2C%%22%%5B%%5Bnull%%2C%%5B%%5C%%22%s%%5C%%22%%5D%%2C%%5B10%%5D%%2C%%5B2%%5D%%2C4%%5D%%5D%%22%%5D%%5D%%5D", queryEscape(term))
 
 	headers := http.Header{}
 	headers.Set("Content-Type", "application/x-www-form-urlencoded;charset=UTF-8")
-	for k, vv := range opts.Headers {
+	for k, vv := range callOpts.Headers {
 		for _, v := range vv {
 			headers.Add(k, v)
 		}
 	}
 
-	respBody, _, err := c.do(ctx, requestOptions{Method: http.MethodPost, URL: u, Body: []byte(body), Headers: headers}, opts.Throttle)
+	respBody, _, err := c.do(ctx, requestOptions{Method: http.MethodPost, URL: u, Body: []byte(body), Headers: headers}, callOpts.Throttle)
 	if err != nil {
 		return nil, err
 	}
@@ -61,7 +100,7 @@ func (c *Client) Suggest(ctx context.Context, opts SuggestOptions) ([]string, er
 		return nil, err
 	}
 	if inner == nil {
-		return []string{}, nil
+		return nil, nil
 	}
 
 	b, err := json.Marshal(inner)
@@ -72,16 +111,48 @@ func (c *Client) Suggest(ctx context.Context, opts SuggestOptions) ([]string, er
 	if err := json.Unmarshal(b, &data); err != nil {
 		return nil, err
 	}
-	root, ok := pathGet(data, []any{0, 0}).([]any)
-	if !ok {
-		return []string{}, nil
+	root, _ := pathGet(data, []any{0, 0}).([]any)
+	return root, nil
+}
+
+func extractSuggestions(items []any) []Suggestion {
+	out := make([]Suggestion, 0, len(items))
+	for _, it := range items {
+		term, ok := pathGet(it, []any{0}).(string)
+		if !ok {
+			continue
+		}
+		s := Suggestion{Term: term, Kind: SuggestionQuery, Position: len(out) + 1}
+		if arr, ok := it.([]any); ok && len(arr) > 1 {
+			s.AppID, s.Icon = suggestionEntity(arr[1:], term)
+		}
+		if s.AppID != "" {
+			s.Kind = SuggestionApp
+		}
+		out = append(out, s)
 	}
-	out := make([]string, 0, len(root))
-	for _, it := range root {
-		if s, ok := pathGet(it, []any{0}).(string); ok {
-			out = append(out, s)
+	return out
+}
+
+func suggestionEntity(v any, term string) (appID, icon string) {
+	var walk func(v any)
+	walk = func(v any) {
+		switch t := v.(type) {
+		case string:
+			switch {
+			case icon == "" && strings.HasPrefix(t, "https://play-lh.googleusercontent.com/"):
+				icon = t
+			case appID == "" && strings.Contains(t, "/store/apps/details?id="):
+				appID = strings.SplitN(strings.SplitN(t, "id=", 2)[1], "&", 2)[0]
+			case appID == "" && t != term && packageNameRe.MatchString(t):
+				appID = t
+			}
+		case []any:
+			for _, sub := range t {
+				walk(sub)
+			}
 		}
 	}
-	c.cacheSet("suggest", cacheOpts, out)
-	return out, nil
+	walk(v)
+	return appID, icon
 }
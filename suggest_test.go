@@ -0,0 +1,25 @@
+package gplay
+
+import "testing"
+
+func TestExtractSuggestions(t *testing.T) {
+	items := []any{
+		[]any{"spotify"},
+		[]any{"Spotify: Music and Podcasts", []any{nil, []any{"com.spotify.music", 7}, []any{nil, nil, "https://play-lh.googleusercontent.com/abc"}}},
+		[]any{"spotify premium", []any{nil, "/store/apps/details?id=com.example.premium&hl=en"}},
+		[]any{nil},
+	}
+	sugs := extractSuggestions(items)
+	if len(sugs) != 3 {
+		t.Fatalf("unexpected suggestions %+v", sugs)
+	}
+	if sugs[0].Kind != SuggestionQuery || sugs[0].AppID != "" || sugs[0].Position != 1 {
+		t.Fatalf("unexpected query suggestion %+v", sugs[0])
+	}
+	if sugs[1].Kind != SuggestionApp || sugs[1].AppID != "com.spotify.music" || sugs[1].Icon != "https://play-lh.googleusercontent.com/abc" {
+		t.Fatalf("unexpected app suggestion %+v", sugs[1])
+	}
+	if sugs[2].AppID != "com.example.premium" || sugs[2].Position != 3 {
+		t.Fatalf("unexpected link suggestion %+v", sugs[2])
+	}
+}
@@ -0,0 +1,156 @@
+package gplay
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+type CategoryNode struct {
+	ID       Category   `json:"id"`
+	Name     string     `json:"name"`
+	Parent   Category   `json:"parent,omitempty"`
+	Children []Category `json:"children,omitempty"`
+	Known    bool       `json:"known"`
+}
+
+type CategoryTaxonomy struct {
+	Lang       string         `json:"lang"`
+	Categories []CategoryNode `json:"categories"`
+	Added      []Category     `json:"added"`
+	Retired    []Category     `json:"retired"`
+}
+
+func CategoryParent(cat Category) Category {
+	s := string(cat)
+	switch {
+	case cat == CategoryApplication || cat == CategoryGame || cat == CategoryFamily:
+		return ""
+	case strings.HasPrefix(s, "GAME_"):
+		return CategoryGame
+	case strings.HasPrefix(s, "FAMILY_"):
+		return CategoryFamily
+	default:
+		return CategoryApplication
+	}
+}
+
+var taxonomyRoots = []struct {
+	category Category
+	path     string
+}{
+	{CategoryApplication, "/store/apps"},
+	{CategoryGame, "/store/games"},
+}
+
+func (c *Client) CategoryTaxonomy(ctx context.Context, opts CategoryTaxonomyOptions) (CategoryTaxonomy, error) {
+	lang := opts.Lang
+	if lang == "" {
+		lang = "en"
+	}
+	country := opts.Country
+	if country == "" {
+		country = "us"
+	}
+	cacheOpts := opts
+	cacheOpts.Lang = lang
+	cacheOpts.Country = country
+	if c != nil && c.cache != nil {
+		var cached CategoryTaxonomy
+		hit, err := c.cacheGet("categoryTaxonomy", cacheOpts, &cached)
+		if err != nil {
+			return CategoryTaxonomy{}, err
+		}
+		if hit {
+			return cached, nil
+		}
+	}
+
+	qs := url.Values{}
+	qs.Set("hl", lang)
+	qs.Set("gl", country)
+	var links []categoryLink
+	fetched := map[Category]bool{}
+	for _, root := range taxonomyRoots {
+		body, _, err := c.do(ctx, requestOptions{URL: root.path + "?" + encodeValues(qs), Headers: opts.Headers}, opts.Throttle)
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return CategoryTaxonomy{}, err
+		}
+		found, err := harvestCategoryLinks(body)
+		if err != nil {
+			return CategoryTaxonomy{}, err
+		}
+		links = append(links, found...)
+		fetched[root.category] = true
+	}
+	if len(links) == 0 {
+		return CategoryTaxonomy{}, errors.New("no categories found")
+	}
+
+	tax := buildTaxonomy(lang, links, fetched)
+	c.cacheSet("categoryTaxonomy", cacheOpts, tax)
+	return tax, nil
+}
+
+func buildTaxonomy(lang string, links []categoryLink, fetched map[Category]bool) CategoryTaxonomy {
+	known := make(map[Category]bool, len(AllCategories))
+	for _, cat := range AllCategories {
+		known[cat] = true
+	}
+
+	tax := CategoryTaxonomy{Lang: lang}
+	index := map[Category]int{}
+	add := func(id Category, name string) {
+		if i, ok := index[id]; ok {
+			if tax.Categories[i].Name == "" {
+				tax.Categories[i].Name = name
+			}
+			return
+		}
+		index[id] = len(tax.Categories)
+		tax.Categories = append(tax.Categories, CategoryNode{ID: id, Name: name, Parent: CategoryParent(id), Known: known[id]})
+	}
+	for _, root := range []Category{CategoryApplication, CategoryGame, CategoryFamily} {
+		add(root, "")
+	}
+	for _, l := range links {
+		add(Category(l.ID), l.Name)
+	}
+
+	for _, node := range tax.Categories {
+		if node.Parent == "" {
+			continue
+		}
+		if i, ok := index[node.Parent]; ok {
+			tax.Categories[i].Children = append(tax.Categories[i].Children, node.ID)
+		}
+	}
+
+	seen := map[Category]bool{}
+	for _, l := range links {
+		id := Category(l.ID)
+		if !known[id] && !seen[id] {
+			tax.Added = append(tax.Added, id)
+		}
+		seen[id] = true
+	}
+	for _, cat := range AllCategories {
+		if parent := CategoryParent(cat); !seen[cat] && parent != "" && fetched[parent] {
+			tax.Retired = append(tax.Retired, cat)
+		}
+	}
+	return tax
+}
+
+func (t CategoryTaxonomy) Name(cat Category) string {
+	for _, n := range t.Categories {
+		if n.ID == cat {
+			return n.Name
+		}
+	}
+	return ""
+}
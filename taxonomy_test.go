@@ -0,0 +1,54 @@
+package gplay
+
+import "testing"
+
+func TestBuildTaxonomy(t *testing.T) {
+	body := []byte(`<html><body>
+<a href="/store/apps/category/GAME">Spiele</a>
+<a href="/store/apps/category/GAME_PUZZLE">Puzzle</a>
+<a href="/store/apps/category/TOOLS">Tools</a>
+<a href="/store/apps/category/FAMILY?age=AGE_RANGE1">Bis 5 Jahre</a>
+<a href="/store/apps/category/AI_ASSISTANTS">KI-Assistenten</a>
+</body></html>`)
+	links, err := harvestCategoryLinks(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tax := buildTaxonomy("de", links, map[Category]bool{CategoryApplication: true})
+
+	if tax.Name(CategoryGamePuzzle) != "Puzzle" || tax.Name(CategoryGame) != "Spiele" {
+		t.Fatalf("unexpected names %+v", tax.Categories)
+	}
+	for _, n := range tax.Categories {
+		if n.ID == CategoryGame && (len(n.Children) != 1 || n.Children[0] != CategoryGamePuzzle) {
+			t.Fatalf("unexpected GAME children %v", n.Children)
+		}
+		if n.ID == "AI_ASSISTANTS" && (n.Known || n.Parent != CategoryApplication) {
+			t.Fatalf("unexpected new category node %+v", n)
+		}
+	}
+	if len(tax.Added) != 1 || tax.Added[0] != "AI_ASSISTANTS" {
+		t.Fatalf("unexpected added %v", tax.Added)
+	}
+	retired := func(tax CategoryTaxonomy, cat Category) bool {
+		for _, r := range tax.Retired {
+			if r == cat {
+				return true
+			}
+		}
+		return false
+	}
+	for _, cat := range []Category{CategoryTools, CategoryGamePuzzle, CategoryGame, CategoryGameAction} {
+		if retired(tax, cat) {
+			t.Fatalf("%s should not be retired", cat)
+		}
+	}
+	if !retired(tax, CategoryWeather) {
+		t.Fatalf("expected %s to be retired, got %v", CategoryWeather, tax.Retired)
+	}
+
+	tax = buildTaxonomy("de", append(links, links...), map[Category]bool{CategoryApplication: true, CategoryGame: true})
+	if !retired(tax, CategoryGameAction) || retired(tax, CategoryGamePuzzle) || len(tax.Added) != 1 {
+		t.Fatalf("unexpected drift with the games page %v %v", tax.Retired, tax.Added)
+	}
+}
@@ -0,0 +1,168 @@
+package gplay
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var androidAPILevels = []struct {
+	version string
+	level   int
+}{
+	{"1.0", 1}, {"1.1", 2}, {"1.5", 3}, {"1.6", 4}, {"2.0", 5}, {"2.0.1", 6}, {"2.1", 7},
+	{"2.2", 8}, {"2.3", 9}, {"2.3.3", 10}, {"3.0", 11}, {"3.1", 12}, {"3.2", 13},
+	{"4.0", 14}, {"4.0.3", 15}, {"4.1", 16}, {"4.2", 17}, {"4.3", 18}, {"4.4", 19},
+	{"4.4W", 20}, {"5.0", 21}, {"5.1", 22}, {"6.0", 23}, {"7.0", 24}, {"7.1", 25},
+	{"8.0", 26}, {"8.1", 27}, {"9", 28}, {"10", 29}, {"11", 30}, {"12", 31},
+	{"12L", 32}, {"13", 33}, {"14", 34}, {"15", 35}, {"16", 36},
+}
+
+func AndroidAPILevel(version string) (int, bool) {
+	version = strings.TrimSpace(version)
+	if version == "" || version == "VARY" {
+		return 0, false
+	}
+	for _, e := range androidAPILevels {
+		if strings.EqualFold(e.version, version) {
+			return e.level, true
+		}
+	}
+	want, ok := parseVersionParts(version)
+	if !ok {
+		return 0, false
+	}
+	level := 0
+	for _, e := range androidAPILevels {
+		have, ok := parseVersionParts(e.version)
+		if !ok {
+			continue
+		}
+		if compareVersionParts(have, want) <= 0 {
+			level = e.level
+		}
+	}
+	return level, level > 0
+}
+
+func parseVersionParts(v string) ([]int, bool) {
+	parts := strings.Split(v, ".")
+	out := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, false
+		}
+		out = append(out, n)
+	}
+	return out, true
+}
+
+func compareVersionParts(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func ParseSize(s string) (int64, bool) {
+	s = strings.TrimSpace(normalizeDigits(s))
+	if s == "" {
+		return 0, false
+	}
+	end := 0
+	for end < len(s) && (s[end] >= '0' && s[end] <= '9' || s[end] == '.' || s[end] == ',') {
+		end++
+	}
+	if end == 0 {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(strings.ReplaceAll(s[:end], ",", "."), 64)
+	if err != nil {
+		return 0, false
+	}
+	unit := strings.ToUpper(strings.TrimSpace(s[end:]))
+	mult := float64(1)
+	switch {
+	case strings.HasPrefix(unit, "K"):
+		mult = 1 << 10
+	case strings.HasPrefix(unit, "M"):
+		mult = 1 << 20
+	case strings.HasPrefix(unit, "G"):
+		mult = 1 << 30
+	case unit == "" || strings.HasPrefix(unit, "B"):
+	default:
+		return 0, false
+	}
+	return int64(n * mult), true
+}
+
+func (a App) Lang() string {
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("hl")
+}
+
+func (a App) ReleasedTime() (time.Time, bool) {
+	if a.Released == nil {
+		return time.Time{}, false
+	}
+	t, err := ParseReleased(*a.Released, a.Lang())
+	return t, err == nil
+}
+
+func (a App) UpdatedTime() (time.Time, bool) {
+	if a.Updated == nil || *a.Updated == 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(*a.Updated).UTC(), true
+}
+
+func (a App) SizeBytes() (int64, bool) {
+	if a.Size == nil {
+		return 0, false
+	}
+	return ParseSize(*a.Size)
+}
+
+func (a App) MinAPILevel() (int, bool) {
+	if a.AndroidVersion == nil {
+		return 0, false
+	}
+	return AndroidAPILevel(*a.AndroidVersion)
+}
+
+func (a App) MaxAPILevel() (int, bool) {
+	if a.AndroidMaxVersion == nil {
+		return 0, false
+	}
+	return AndroidAPILevel(*a.AndroidMaxVersion)
+}
+
+func (r Review) Time() (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339Nano, r.Date)
+	return t, err == nil
+}
+
+func (r Review) ReplyTime() (time.Time, bool) {
+	if r.ReplyDate == nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, *r.ReplyDate)
+	return t, err == nil
+}
@@ -0,0 +1,80 @@
+package gplay
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseReleased(t *testing.T) {
+	want := time.Date(2015, time.January, 7, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		lang string
+		in   string
+	}{
+		{"en", "Jan 7, 2015"},
+		{"en", "January 7, 2015"},
+		{"es", "7 ene 2015"},
+		{"pt-BR", "7 de jan. de 2015"},
+		{"fr", "7 janv. 2015"},
+		{"de", "07.01.2015"},
+		{"it", "7 gen 2015"},
+		{"ru", "7 янв. 2015 г."},
+		{"ja", "2015/01/07"},
+		{"ko", "2015. 1. 7."},
+		{"zh-CN", "2015年1月7日"},
+		{"ar", "٧ يناير ٢٠١٥"},
+		{"tr", "7 Oca 2015"},
+		{"", "7 janv. 2015"},
+	}
+	for _, tc := range cases {
+		got, err := ParseReleased(tc.in, tc.lang)
+		if err != nil {
+			t.Errorf("%s %q: %v", tc.lang, tc.in, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("%s %q: got %s", tc.lang, tc.in, got)
+		}
+	}
+
+	may, err := ParseReleased("7 мая 2015 г.", "ru")
+	if err != nil || may.Month() != time.May {
+		t.Fatalf("expected May, got %s (%v)", may, err)
+	}
+	if _, err := ParseReleased("Varies with device", "en"); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want int64
+		ok   bool
+	}{
+		{"23M", 23 << 20, true},
+		{"1.5G", 3 << 29, true},
+		{"512k", 512 << 10, true},
+		{"12,5 MB", 25 << 19, true},
+		{"Varies with device", 0, false},
+	}
+	for _, tc := range cases {
+		got, ok := ParseSize(tc.in)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("%q: got %d,%t want %d,%t", tc.in, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestAndroidAPILevel(t *testing.T) {
+	cases := map[string]int{"4.4": 19, "4.0.4": 15, "5.0": 21, "8.1": 27, "9": 28, "13": 33, "12L": 32}
+	for in, want := range cases {
+		got, ok := AndroidAPILevel(in)
+		if !ok || got != want {
+			t.Errorf("%q: got %d,%t want %d", in, got, ok, want)
+		}
+	}
+	if _, ok := AndroidAPILevel("VARY"); ok {
+		t.Fatalf("expected VARY to have no level")
+	}
+}
@@ -1,6 +1,10 @@
 package gplay
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"testing"
+)
 
 func TestPathGet(t *testing.T) {
 	root := parsedData{
@@ -24,3 +28,23 @@ func TestParseListResponse(t *testing.T) {
 		t.Fatalf("expected [1,2], got %#v", out)
 	}
 }
+
+func TestOptionErrors(t *testing.T) {
+	c := &Client{}
+	ctx := context.Background()
+	checks := []error{
+		func() error { _, err := c.App(ctx, AppOptions{}); return err }(),
+		func() error { _, err := c.Search(ctx, SearchOptions{}); return err }(),
+		func() error { _, err := c.Reviews(ctx, ReviewsOptions{}); return err }(),
+		func() error { _, err := c.Similar(ctx, SimilarOptions{}); return err }(),
+	}
+	for i, err := range checks {
+		var optErr *OptionError
+		if !errors.Is(err, ErrInvalidOption) || !errors.As(err, &optErr) || optErr.Message == "" {
+			t.Errorf("check %d: expected option error, got %v", i, err)
+		}
+	}
+	if errors.Is(&RequestError{StatusCode: 404}, ErrInvalidOption) {
+		t.Fatal("request errors must not match ErrInvalidOption")
+	}
+}